@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Visibilities of an organization secret or variable.
+const (
+	VisibilityAll      = "all"
+	VisibilityPrivate  = "private"
+	VisibilitySelected = "selected"
+)
+
+// OrganizationActionsSecretParameters defines the desired state of a GitHub
+// Actions secret of a GitHub organization.
+type OrganizationActionsSecretParameters struct {
+	// Name of the organization.
+	// +immutable
+	Organization string `json:"organization"`
+
+	// The name of the secret. It can only contain alphanumeric characters
+	// and underscores, and is converted to upper case by GitHub.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// ValueSecretRef references the key of a Secret that holds the value of
+	// the secret. GitHub never returns it, a change of its value is detected
+	// by the version of the Secret it is read from.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+
+	// Which repositories of the organization can access the secret. Can be
+	// all, private or selected.
+	// +kubebuilder:validation:Enum=all;private;selected
+	Visibility string `json:"visibility"`
+
+	// The IDs of the repositories that can access the secret when its
+	// visibility is selected.
+	// +optional
+	SelectedRepositoryIDs []int64 `json:"selectedRepositoryIds,omitempty"`
+
+	// SelectedRepositoryRefs references Repositories to retrieve their IDs.
+	// +optional
+	SelectedRepositoryRefs []xpv1.Reference `json:"selectedRepositoryRefs,omitempty"`
+
+	// SelectedRepositorySelector selects references to Repositories to
+	// retrieve their IDs. It is evaluated again on every reconcile, so that
+	// Repositories that match it later are selected too.
+	// +optional
+	SelectedRepositorySelector *xpv1.Selector `json:"selectedRepositorySelector,omitempty"`
+}
+
+// OrganizationActionsSecretSpec defines the desired state of an
+// OrganizationActionsSecret.
+type OrganizationActionsSecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationActionsSecretParameters `json:"forProvider"`
+}
+
+// OrganizationActionsSecretObservation is the representation of the current
+// state that is observed
+type OrganizationActionsSecretObservation struct {
+	// Which repositories of the organization can access the secret.
+	Visibility string `json:"visibility,omitempty"`
+
+	// The names of the repositories that can access the secret when its
+	// visibility is selected.
+	SelectedRepositories []string `json:"selectedRepositories,omitempty"`
+
+	// CreatedAt is the time the secret was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the value of the secret was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// OrganizationActionsSecretStatus represents the observed state of an
+// OrganizationActionsSecret.
+type OrganizationActionsSecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationActionsSecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationActionsSecret is a managed resource that represents a GitHub
+// Actions secret of a GitHub organization
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organization"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="VISIBILITY",type="string",JSONPath=".spec.forProvider.visibility"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type OrganizationActionsSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationActionsSecretSpec   `json:"spec"`
+	Status OrganizationActionsSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationActionsSecretList contains a list of OrganizationActionsSecret
+type OrganizationActionsSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationActionsSecret `json:"items"`
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	repositories "github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+// OrganizationActionsVariableParameters defines the desired state of a GitHub
+// Actions configuration variable of a GitHub organization.
+type OrganizationActionsVariableParameters struct {
+	// Name of the organization.
+	// +immutable
+	Organization string `json:"organization"`
+
+	// The name of the variable. It can only contain alphanumeric characters
+	// and underscores, and is converted to upper case by GitHub.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// The value of the variable. Either it or ValueConfigMapRef is required.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueConfigMapRef references the key of a ConfigMap that holds the
+	// value of the variable.
+	// +optional
+	ValueConfigMapRef *repositories.ConfigMapKeySelector `json:"valueConfigMapRef,omitempty"`
+
+	// Which repositories of the organization can access the variable. Can
+	// be all, private or selected.
+	// +kubebuilder:validation:Enum=all;private;selected
+	Visibility string `json:"visibility"`
+
+	// The IDs of the repositories that can access the variable when its
+	// visibility is selected.
+	// +optional
+	SelectedRepositoryIDs []int64 `json:"selectedRepositoryIds,omitempty"`
+
+	// SelectedRepositoryRefs references Repositories to retrieve their IDs.
+	// +optional
+	SelectedRepositoryRefs []xpv1.Reference `json:"selectedRepositoryRefs,omitempty"`
+
+	// SelectedRepositorySelector selects references to Repositories to
+	// retrieve their IDs. It is evaluated again on every reconcile, so that
+	// Repositories that match it later are selected too.
+	// +optional
+	SelectedRepositorySelector *xpv1.Selector `json:"selectedRepositorySelector,omitempty"`
+}
+
+// OrganizationActionsVariableSpec defines the desired state of an
+// OrganizationActionsVariable.
+type OrganizationActionsVariableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationActionsVariableParameters `json:"forProvider"`
+}
+
+// OrganizationActionsVariableObservation is the representation of the current
+// state that is observed
+type OrganizationActionsVariableObservation struct {
+	// Which repositories of the organization can access the variable.
+	Visibility string `json:"visibility,omitempty"`
+
+	// The names of the repositories that can access the variable when its
+	// visibility is selected.
+	SelectedRepositories []string `json:"selectedRepositories,omitempty"`
+
+	// CreatedAt is the time the variable was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the variable was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// OrganizationActionsVariableStatus represents the observed state of an
+// OrganizationActionsVariable.
+type OrganizationActionsVariableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationActionsVariableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationActionsVariable is a managed resource that represents a
+// GitHub Actions configuration variable of a GitHub organization
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organization"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="VISIBILITY",type="string",JSONPath=".spec.forProvider.visibility"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type OrganizationActionsVariable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationActionsVariableSpec   `json:"spec"`
+	Status OrganizationActionsVariableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationActionsVariableList contains a list of
+// OrganizationActionsVariable
+type OrganizationActionsVariableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationActionsVariable `json:"items"`
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	repositories "github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+// RulesetRepositoryNameCondition selects the repositories an
+// OrganizationRuleset applies to.
+type RulesetRepositoryNameCondition struct {
+	// The repository name patterns to include. Accepts ~ALL for all
+	// repositories.
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// The repository name patterns to exclude.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+
+	// Whether renaming of the selected repositories is prevented.
+	// +optional
+	Protected bool `json:"protected,omitempty"`
+}
+
+// OrganizationRulesetConditions select the repositories and refs an
+// OrganizationRuleset applies to.
+type OrganizationRulesetConditions struct {
+	// RefName selects the branches or tags by name.
+	// +optional
+	RefName *repositories.RulesetRefNameCondition `json:"refName,omitempty"`
+
+	// RepositoryName selects the repositories by name.
+	RepositoryName RulesetRepositoryNameCondition `json:"repositoryName"`
+}
+
+// OrganizationRulesetParameters defines the desired state of a ruleset of a
+// GitHub organization.
+type OrganizationRulesetParameters struct {
+	// Name of the organization.
+	// +immutable
+	Organization string `json:"organization"`
+
+	// The name of the ruleset.
+	Name string `json:"name"`
+
+	// The target of the ruleset. Can be branch or tag.
+	// Default is "branch".
+	// +optional
+	// +kubebuilder:validation:Enum=branch;tag
+	Target *string `json:"target,omitempty"`
+
+	// The enforcement level of the ruleset. Rules of an evaluate ruleset are
+	// not enforced, but their outcome is reported.
+	// +kubebuilder:validation:Enum=disabled;active;evaluate
+	Enforcement string `json:"enforcement"`
+
+	// The actors that can bypass the rules.
+	// +optional
+	BypassActors []repositories.RulesetBypassActor `json:"bypassActors,omitempty"`
+
+	// The repositories and refs the ruleset applies to.
+	Conditions OrganizationRulesetConditions `json:"conditions"`
+
+	// The rules of the ruleset.
+	// +optional
+	Rules []repositories.RulesetRule `json:"rules,omitempty"`
+}
+
+// OrganizationRulesetSpec defines the desired state of an OrganizationRuleset.
+type OrganizationRulesetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationRulesetParameters `json:"forProvider"`
+}
+
+// OrganizationRulesetObservation is the representation of the current state
+// that is observed
+type OrganizationRulesetObservation struct {
+	// The ID of the ruleset.
+	ID int64 `json:"id,omitempty"`
+
+	// The NodeID of the ruleset.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// The type of the source of the ruleset.
+	SourceType string `json:"sourceType,omitempty"`
+
+	// The name of the source of the ruleset.
+	Source string `json:"source,omitempty"`
+}
+
+// OrganizationRulesetStatus represents the observed state of an
+// OrganizationRuleset.
+type OrganizationRulesetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationRulesetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationRuleset is a managed resource that represents a ruleset of a
+// GitHub organization
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organization"
+// +kubebuilder:printcolumn:name="ENFORCEMENT",type="string",JSONPath=".spec.forProvider.enforcement"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type OrganizationRuleset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationRulesetSpec   `json:"spec"`
+	Status OrganizationRulesetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationRulesetList contains a list of OrganizationRuleset
+type OrganizationRulesetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationRuleset `json:"items"`
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	repositories "github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+// OrganizationWebhookParameters defines the desired state of a webhook of a
+// GitHub organization.
+type OrganizationWebhookParameters struct {
+	// Name of the organization.
+	// +immutable
+	Organization string `json:"organization"`
+
+	repositories.WebhookParameters `json:",inline"`
+}
+
+// OrganizationWebhookSpec defines the desired state of an OrganizationWebhook.
+type OrganizationWebhookSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationWebhookParameters `json:"forProvider"`
+}
+
+// WebhookDelivery is a delivery of the payload of an event to a webhook.
+type WebhookDelivery struct {
+	// The ID of the delivery.
+	ID int64 `json:"id,omitempty"`
+
+	// The GUID of the event that was delivered.
+	GUID string `json:"guid,omitempty"`
+
+	// DeliveredAt is the time of the delivery.
+	DeliveredAt *metav1.Time `json:"deliveredAt,omitempty"`
+
+	// Whether the delivery is a redelivery of an earlier one.
+	Redelivery bool `json:"redelivery,omitempty"`
+
+	// The status of the delivery.
+	Status string `json:"status,omitempty"`
+
+	// The status code of the response to the delivery.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// The event that was delivered.
+	Event string `json:"event,omitempty"`
+
+	// The action of the event that was delivered.
+	Action string `json:"action,omitempty"`
+}
+
+// OrganizationWebhookObservation is the representation of the current state
+// that is observed
+type OrganizationWebhookObservation struct {
+	// The ID of the webhook.
+	ID int64 `json:"id,omitempty"`
+
+	// The API URL of the webhook.
+	URL string `json:"url,omitempty"`
+
+	// The URL that triggers a ping event to be sent to the webhook.
+	PingURL string `json:"pingUrl,omitempty"`
+
+	// The status code of the response to the last delivery.
+	LastResponseCode int `json:"lastResponseCode,omitempty"`
+
+	// The status of the last delivery.
+	LastResponseStatus string `json:"lastResponseStatus,omitempty"`
+
+	// LastDelivery is the most recent delivery to the webhook, which may be
+	// a redelivery.
+	LastDelivery *WebhookDelivery `json:"lastDelivery,omitempty"`
+
+	// CreatedAt is the time the webhook was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the webhook was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// OrganizationWebhookStatus represents the observed state of an
+// OrganizationWebhook.
+type OrganizationWebhookStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationWebhookObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationWebhook is a managed resource that represents a webhook of a
+// GitHub organization
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organization"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type OrganizationWebhook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationWebhookSpec   `json:"spec"`
+	Status OrganizationWebhookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationWebhookList contains a list of OrganizationWebhook
+type OrganizationWebhookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationWebhook `json:"items"`
+}
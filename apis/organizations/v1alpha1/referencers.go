@@ -0,0 +1,303 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	repositories "github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+// TeamID extracts the ID of a Team.
+func TeamID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*Team)
+		if !ok || t.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.FormatInt(t.Status.AtProvider.ID, 10)
+	}
+}
+
+// TeamSlug extracts the slug of a Team. It is only known once the Team was
+// observed, before that its external name may not be the slug yet.
+func TeamSlug() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*Team)
+		if !ok {
+			return ""
+		}
+		return t.Status.AtProvider.Slug
+	}
+}
+
+// ActiveMembershipUser extracts the username of a Membership once the user
+// accepted the invitation to the organization.
+func ActiveMembershipUser() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		m, ok := mg.(*Membership)
+		if !ok || m.Status.AtProvider.State == nil || *m.Status.AtProvider.State != "active" {
+			return ""
+		}
+		return m.Spec.ForProvider.User
+	}
+}
+
+// ResolveReferences of this Team.
+func (mg *Team) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromInt64Ptr(mg.Spec.ForProvider.ParentTeamID),
+		Reference:    mg.Spec.ForProvider.ParentTeamIDRef,
+		Selector:     mg.Spec.ForProvider.ParentTeamIDSelector,
+		To:           reference.To{Managed: &Team{}, List: &TeamList{}},
+		Extract:      TeamID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentTeamId")
+	}
+	id, err := toInt64Ptr(rsp.ResolvedValue)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentTeamId")
+	}
+	mg.Spec.ForProvider.ParentTeamID = id
+	mg.Spec.ForProvider.ParentTeamIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TeamMembership.
+func (mg *TeamMembership) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Team,
+		Reference:    mg.Spec.ForProvider.TeamRef,
+		Selector:     mg.Spec.ForProvider.TeamSelector,
+		To:           reference.To{Managed: &Team{}, List: &TeamList{}},
+		Extract:      TeamSlug(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.team")
+	}
+	mg.Spec.ForProvider.Team = rsp.ResolvedValue
+	mg.Spec.ForProvider.TeamRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.User,
+		Reference:    mg.Spec.ForProvider.MembershipRef,
+		Selector:     mg.Spec.ForProvider.MembershipSelector,
+		To:           reference.To{Managed: &Membership{}, List: &MembershipList{}},
+		Extract:      ActiveMembershipUser(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.user")
+	}
+	mg.Spec.ForProvider.User = rsp.ResolvedValue
+	mg.Spec.ForProvider.MembershipRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TeamRepository.
+func (mg *TeamRepository) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Team,
+		Reference:    mg.Spec.ForProvider.TeamRef,
+		Selector:     mg.Spec.ForProvider.TeamSelector,
+		To:           reference.To{Managed: &Team{}, List: &TeamList{}},
+		Extract:      TeamSlug(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.team")
+	}
+	mg.Spec.ForProvider.Team = rsp.ResolvedValue
+	mg.Spec.ForProvider.TeamRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &repositories.Repository{}, List: &repositories.RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationActionsSecret.
+func (mg *OrganizationActionsSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	ids, refs, err := resolveSelectedRepositoryIDs(ctx, c, mg, p.SelectedRepositoryIDs, p.SelectedRepositoryRefs, p.SelectedRepositorySelector)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.selectedRepositoryIds")
+	}
+	p.SelectedRepositoryIDs = ids
+	p.SelectedRepositoryRefs = refs
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationDependabotSecret.
+func (mg *OrganizationDependabotSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	ids, refs, err := resolveSelectedRepositoryIDs(ctx, c, mg, p.SelectedRepositoryIDs, p.SelectedRepositoryRefs, p.SelectedRepositorySelector)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.selectedRepositoryIds")
+	}
+	p.SelectedRepositoryIDs = ids
+	p.SelectedRepositoryRefs = refs
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationCodespacesSecret.
+func (mg *OrganizationCodespacesSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	ids, refs, err := resolveSelectedRepositoryIDs(ctx, c, mg, p.SelectedRepositoryIDs, p.SelectedRepositoryRefs, p.SelectedRepositorySelector)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.selectedRepositoryIds")
+	}
+	p.SelectedRepositoryIDs = ids
+	p.SelectedRepositoryRefs = refs
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationActionsVariable.
+func (mg *OrganizationActionsVariable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	ids, refs, err := resolveSelectedRepositoryIDs(ctx, c, mg, p.SelectedRepositoryIDs, p.SelectedRepositoryRefs, p.SelectedRepositorySelector)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.selectedRepositoryIds")
+	}
+	p.SelectedRepositoryIDs = ids
+	p.SelectedRepositoryRefs = refs
+
+	return nil
+}
+
+// resolveSelectedRepositoryIDs resolves the IDs of the repositories that can
+// access an organization secret or variable. Resolved values are not cached,
+// so that they follow the Repositories that are referenced or selected. The
+// selector is evaluated again to select Repositories that were created since.
+func resolveSelectedRepositoryIDs(ctx context.Context, c client.Reader, mg resource.Managed, ids []int64, refs []xpv1.Reference, sel *xpv1.Selector) ([]int64, []xpv1.Reference, error) {
+	if sel != nil && !meta.WasDeleted(mg) {
+		return selectRepositoryIDs(ctx, c, mg, sel)
+	}
+	current := fromInt64s(ids)
+	if len(refs) > 0 {
+		current = nil
+	}
+	rsp, err := reference.NewAPIResolver(c, mg).ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: current,
+		References:    refs,
+		To:            reference.To{Managed: &repositories.Repository{}, List: &repositories.RepositoryList{}},
+		Extract:       repositories.RepositoryID(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	ids, err = toInt64s(rsp.ResolvedValues)
+	return ids, rsp.ResolvedReferences, err
+}
+
+// selectRepositoryIDs returns the IDs of the Repositories the supplied
+// selector matches. Repositories whose ID is not known yet are skipped until
+// they are observed, and a selector that matches none selects no
+// repositories.
+func selectRepositoryIDs(ctx context.Context, c client.Reader, mg resource.Managed, sel *xpv1.Selector) ([]int64, []xpv1.Reference, error) {
+	l := &repositories.RepositoryList{}
+	if err := c.List(ctx, l, client.MatchingLabels(sel.MatchLabels)); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot list Repositories")
+	}
+	var ids []int64
+	var refs []xpv1.Reference
+	for i := range l.Items {
+		r := &l.Items[i]
+		if reference.ControllersMustMatch(sel) && !meta.HaveSameController(mg, r) {
+			continue
+		}
+		if r.Status.AtProvider.ID == 0 {
+			continue
+		}
+		ids = append(ids, r.Status.AtProvider.ID)
+		refs = append(refs, xpv1.Reference{Name: r.GetName()})
+	}
+	return ids, refs, nil
+}
+
+func fromInt64Ptr(i *int64) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.FormatInt(*i, 10)
+}
+
+func toInt64Ptr(s string) (*int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+func fromInt64s(is []int64) []string {
+	if is == nil {
+		return nil
+	}
+	s := make([]string, len(is))
+	for i := range is {
+		s[i] = strconv.FormatInt(is[i], 10)
+	}
+	return s
+}
+
+func toInt64s(s []string) ([]int64, error) {
+	if s == nil {
+		return nil, nil
+	}
+	is := make([]int64, len(s))
+	for i := range s {
+		v, err := strconv.ParseInt(s[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		is[i] = v
+	}
+	return is, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	repositories "github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func repository(name string, id int64) repositories.Repository {
+	r := repositories.Repository{}
+	r.SetName(name)
+	r.Status.AtProvider.ID = id
+	return r
+}
+
+func repositoryList(rs ...repositories.Repository) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*repositories.RepositoryList).Items = rs
+		return nil
+	}
+}
+
+func TestResolveSelectedRepositoryIDs(t *testing.T) {
+	errBoom := errors.New("boom")
+	sel := &xpv1.Selector{MatchLabels: map[string]string{"team": "platform"}}
+
+	type want struct {
+		ids  []int64
+		refs []xpv1.Reference
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   test.MockListFn
+		ids    []int64
+		want   want
+	}{
+		"ListFailed": {
+			reason: "Must return an error if the Repositories cannot be listed",
+			list:   test.NewMockListFn(errBoom),
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot list Repositories"), "spec.forProvider.selectedRepositoryIds"),
+			},
+		},
+		"NoMatches": {
+			reason: "A selector that matches no Repositories must select no repositories",
+			list:   repositoryList(),
+			ids:    []int64{42},
+			want:   want{},
+		},
+		"NotObservedYet": {
+			reason: "Repositories whose ID is not known yet must be skipped",
+			list:   repositoryList(repository("sample", 42), repository("new", 0)),
+			want: want{
+				ids:  []int64{42},
+				refs: []xpv1.Reference{{Name: "sample"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &OrganizationActionsSecret{}
+			mg.Spec.ForProvider.SelectedRepositoryIDs = tc.ids
+			mg.Spec.ForProvider.SelectedRepositorySelector = sel
+
+			err := mg.ResolveReferences(context.Background(), &test.MockClient{MockList: tc.list})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.ids, mg.Spec.ForProvider.SelectedRepositoryIDs); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want IDs, +got IDs:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.refs, mg.Spec.ForProvider.SelectedRepositoryRefs); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want refs, +got refs:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -45,6 +45,87 @@ var (
 	MembershipGroupVersionKind = SchemeGroupVersion.WithKind(MembershipKind)
 )
 
+// Team type metadata.
+var (
+	TeamKind             = reflect.TypeOf(Team{}).Name()
+	TeamGroupKind        = schema.GroupKind{Group: Group, Kind: TeamKind}.String()
+	TeamKindAPIVersion   = TeamKind + "." + SchemeGroupVersion.String()
+	TeamGroupVersionKind = SchemeGroupVersion.WithKind(TeamKind)
+)
+
+// TeamMembership type metadata.
+var (
+	TeamMembershipKind             = reflect.TypeOf(TeamMembership{}).Name()
+	TeamMembershipGroupKind        = schema.GroupKind{Group: Group, Kind: TeamMembershipKind}.String()
+	TeamMembershipKindAPIVersion   = TeamMembershipKind + "." + SchemeGroupVersion.String()
+	TeamMembershipGroupVersionKind = SchemeGroupVersion.WithKind(TeamMembershipKind)
+)
+
+// TeamRepository type metadata.
+var (
+	TeamRepositoryKind             = reflect.TypeOf(TeamRepository{}).Name()
+	TeamRepositoryGroupKind        = schema.GroupKind{Group: Group, Kind: TeamRepositoryKind}.String()
+	TeamRepositoryKindAPIVersion   = TeamRepositoryKind + "." + SchemeGroupVersion.String()
+	TeamRepositoryGroupVersionKind = SchemeGroupVersion.WithKind(TeamRepositoryKind)
+)
+
+// OrganizationRuleset type metadata.
+var (
+	OrganizationRulesetKind             = reflect.TypeOf(OrganizationRuleset{}).Name()
+	OrganizationRulesetGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationRulesetKind}.String()
+	OrganizationRulesetKindAPIVersion   = OrganizationRulesetKind + "." + SchemeGroupVersion.String()
+	OrganizationRulesetGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationRulesetKind)
+)
+
+// OrganizationWebhook type metadata.
+var (
+	OrganizationWebhookKind             = reflect.TypeOf(OrganizationWebhook{}).Name()
+	OrganizationWebhookGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationWebhookKind}.String()
+	OrganizationWebhookKindAPIVersion   = OrganizationWebhookKind + "." + SchemeGroupVersion.String()
+	OrganizationWebhookGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationWebhookKind)
+)
+
+// OrganizationActionsSecret type metadata.
+var (
+	OrganizationActionsSecretKind             = reflect.TypeOf(OrganizationActionsSecret{}).Name()
+	OrganizationActionsSecretGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationActionsSecretKind}.String()
+	OrganizationActionsSecretKindAPIVersion   = OrganizationActionsSecretKind + "." + SchemeGroupVersion.String()
+	OrganizationActionsSecretGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationActionsSecretKind)
+)
+
+// OrganizationActionsVariable type metadata.
+var (
+	OrganizationActionsVariableKind             = reflect.TypeOf(OrganizationActionsVariable{}).Name()
+	OrganizationActionsVariableGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationActionsVariableKind}.String()
+	OrganizationActionsVariableKindAPIVersion   = OrganizationActionsVariableKind + "." + SchemeGroupVersion.String()
+	OrganizationActionsVariableGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationActionsVariableKind)
+)
+
+// OrganizationDependabotSecret type metadata.
+var (
+	OrganizationDependabotSecretKind             = reflect.TypeOf(OrganizationDependabotSecret{}).Name()
+	OrganizationDependabotSecretGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationDependabotSecretKind}.String()
+	OrganizationDependabotSecretKindAPIVersion   = OrganizationDependabotSecretKind + "." + SchemeGroupVersion.String()
+	OrganizationDependabotSecretGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationDependabotSecretKind)
+)
+
+// OrganizationCodespacesSecret type metadata.
+var (
+	OrganizationCodespacesSecretKind             = reflect.TypeOf(OrganizationCodespacesSecret{}).Name()
+	OrganizationCodespacesSecretGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationCodespacesSecretKind}.String()
+	OrganizationCodespacesSecretKindAPIVersion   = OrganizationCodespacesSecretKind + "." + SchemeGroupVersion.String()
+	OrganizationCodespacesSecretGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationCodespacesSecretKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Membership{}, &MembershipList{})
+	SchemeBuilder.Register(&Team{}, &TeamList{})
+	SchemeBuilder.Register(&TeamMembership{}, &TeamMembershipList{})
+	SchemeBuilder.Register(&TeamRepository{}, &TeamRepositoryList{})
+	SchemeBuilder.Register(&OrganizationRuleset{}, &OrganizationRulesetList{})
+	SchemeBuilder.Register(&OrganizationWebhook{}, &OrganizationWebhookList{})
+	SchemeBuilder.Register(&OrganizationActionsSecret{}, &OrganizationActionsSecretList{})
+	SchemeBuilder.Register(&OrganizationActionsVariable{}, &OrganizationActionsVariableList{})
+	SchemeBuilder.Register(&OrganizationDependabotSecret{}, &OrganizationDependabotSecretList{})
+	SchemeBuilder.Register(&OrganizationCodespacesSecret{}, &OrganizationCodespacesSecretList{})
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TeamParameters defines the desired state of a GitHub Team.
+type TeamParameters struct {
+	// Name of the organization that owns the Team.
+	// +immutable
+	Organization string `json:"organization"`
+
+	// The name of the Team. Defaults to the external name of the Team.
+	// The slug of the Team, which is used as its external name, is
+	// derived from the name by GitHub and changes when the name changes.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The description of the Team.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The level of privacy this Team should have. Can be one of:
+	// * secret - only visible to organization owners and members of this
+	//   team.
+	// * closed - visible to all members of this organization.
+	// Default for a non-nested team is "secret", nested teams must be
+	// "closed".
+	// +optional
+	// +kubebuilder:validation:Enum=secret;closed
+	Privacy *string `json:"privacy,omitempty"`
+
+	// The ID of the Team to set as the parent of this Team.
+	// +optional
+	ParentTeamID *int64 `json:"parentTeamId,omitempty"`
+
+	// ParentTeamIDRef references a Team to retrieve its ID.
+	// +optional
+	ParentTeamIDRef *xpv1.Reference `json:"parentTeamIdRef,omitempty"`
+
+	// ParentTeamIDSelector selects a reference to a Team to retrieve its ID.
+	// +optional
+	ParentTeamIDSelector *xpv1.Selector `json:"parentTeamIdSelector,omitempty"`
+
+	// Whether the members of the Team are notified when the Team is
+	// mentioned. Can be one of:
+	// * notifications_enabled - team members receive notifications when the
+	//   team is @mentioned.
+	// * notifications_disabled - no one receives notifications.
+	// Default is "notifications_enabled".
+	// +optional
+	// +kubebuilder:validation:Enum=notifications_enabled;notifications_disabled
+	NotificationSetting *string `json:"notificationSetting,omitempty"`
+
+	// The distinguished name (DN) of the LDAP entry to map to the Team.
+	// Only available in GitHub Enterprise Server with LDAP Sync enabled.
+	// +optional
+	LDAPDN *string `json:"ldapDn,omitempty"`
+}
+
+// TeamSpec defines the desired state of a Team.
+type TeamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamParameters `json:"forProvider"`
+}
+
+// TeamObservation is the representation of the current state that is observed
+type TeamObservation struct {
+	// The ID of the Team.
+	ID int64 `json:"id,omitempty"`
+
+	// The NodeID of the Team.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// The slug of the Team, derived from its name.
+	Slug string `json:"slug,omitempty"`
+
+	// The API URL of the Team.
+	URL string `json:"url,omitempty"`
+
+	// The number of members of the Team.
+	MembersCount int `json:"membersCount,omitempty"`
+
+	// The number of repositories the Team has access to.
+	ReposCount int `json:"reposCount,omitempty"`
+}
+
+// TeamStatus represents the observed state of a Team.
+type TeamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Team is a managed resource that represents a GitHub Team
+// +kubebuilder:printcolumn:name="SLUG",type="string",JSONPath=".status.atProvider.slug"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamSpec   `json:"spec"`
+	Status TeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamList contains a list of Team
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TeamMembershipParameters defines the desired state of a user's membership
+// in a GitHub Team.
+type TeamMembershipParameters struct {
+	// Name of the organization that owns the Team.
+	// +immutable
+	Organization string `json:"organization"`
+
+	// The slug of the Team.
+	// +optional
+	// +immutable
+	Team string `json:"team,omitempty"`
+
+	// TeamRef references a Team to retrieve its slug.
+	// +optional
+	TeamRef *xpv1.Reference `json:"teamRef,omitempty"`
+
+	// TeamSelector selects a reference to a Team to retrieve its slug.
+	// +optional
+	TeamSelector *xpv1.Selector `json:"teamSelector,omitempty"`
+
+	// User is the username of the GitHub user.
+	// +optional
+	// +immutable
+	User string `json:"user,omitempty"`
+
+	// MembershipRef references the organization Membership of the user to
+	// retrieve their username. The username is only resolved once the
+	// Membership is active, so that the user is not invited to the Team
+	// before they joined the organization.
+	// +optional
+	MembershipRef *xpv1.Reference `json:"membershipRef,omitempty"`
+
+	// MembershipSelector selects a reference to the organization Membership
+	// of the user to retrieve their username.
+	// +optional
+	MembershipSelector *xpv1.Selector `json:"membershipSelector,omitempty"`
+
+	// The role of the user in the Team. Can be one of:
+	// * member - a normal member of the team.
+	// * maintainer - a team maintainer. Able to add and remove other team
+	//   members, promote other team members to team maintainer, and edit
+	//   the team's name and description.
+	// Default is "member".
+	// +optional
+	// +kubebuilder:validation:Enum=member;maintainer
+	Role *string `json:"role,omitempty"`
+}
+
+// TeamMembershipSpec defines the desired state of a TeamMembership.
+type TeamMembershipSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamMembershipParameters `json:"forProvider"`
+}
+
+// TeamMembershipObservation is the representation of the current state that
+// is observed
+type TeamMembershipObservation struct {
+	// The API URL of the TeamMembership.
+	URL string `json:"url,omitempty"`
+
+	// State is the user's status within the Team.
+	// Possible values are: "active", "pending"
+	State string `json:"state,omitempty"`
+
+	// Role is the user's role within the Team.
+	Role string `json:"role,omitempty"`
+}
+
+// TeamMembershipStatus represents the observed state of a TeamMembership.
+type TeamMembershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamMembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TeamMembership is a managed resource that represents the membership of a
+// user in a GitHub Team
+// +kubebuilder:printcolumn:name="TEAM",type="string",JSONPath=".spec.forProvider.team"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.forProvider.user"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type TeamMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamMembershipSpec   `json:"spec"`
+	Status TeamMembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamMembershipList contains a list of TeamMembership
+type TeamMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamMembership `json:"items"`
+}
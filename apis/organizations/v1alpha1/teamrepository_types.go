@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TeamRepositoryParameters defines the desired permission of a GitHub Team
+// on a repository.
+type TeamRepositoryParameters struct {
+	// Name of the organization that owns the Team and the repository.
+	// +immutable
+	Organization string `json:"organization"`
+
+	// The slug of the Team.
+	// +optional
+	// +immutable
+	Team string `json:"team,omitempty"`
+
+	// TeamRef references a Team to retrieve its slug.
+	// +optional
+	TeamRef *xpv1.Reference `json:"teamRef,omitempty"`
+
+	// TeamSelector selects a reference to a Team to retrieve its slug.
+	// +optional
+	TeamSelector *xpv1.Selector `json:"teamSelector,omitempty"`
+
+	// The name of the repository. The repository must be owned by the
+	// organization, or be a direct fork of a repository owned by it.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The permission to grant the Team on the repository. Can be one of
+	// pull, triage, push, maintain, admin, or the name of a custom
+	// repository role defined by the organization.
+	Permission string `json:"permission"`
+}
+
+// TeamRepositorySpec defines the desired state of a TeamRepository.
+type TeamRepositorySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamRepositoryParameters `json:"forProvider"`
+}
+
+// TeamRepositoryObservation is the representation of the current state that
+// is observed
+type TeamRepositoryObservation struct {
+	// The permission the Team currently has on the repository.
+	Permission string `json:"permission,omitempty"`
+
+	// The full name of the repository.
+	// The format is {owner}/{repository_name}
+	FullName string `json:"fullName,omitempty"`
+}
+
+// TeamRepositoryStatus represents the observed state of a TeamRepository.
+type TeamRepositoryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamRepositoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TeamRepository is a managed resource that represents the permission of a
+// GitHub Team on a repository
+// +kubebuilder:printcolumn:name="TEAM",type="string",JSONPath=".spec.forProvider.team"
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="PERMISSION",type="string",JSONPath=".status.atProvider.permission"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type TeamRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamRepositorySpec   `json:"spec"`
+	Status TeamRepositoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamRepositoryList contains a list of TeamRepository
+type TeamRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamRepository `json:"items"`
+}
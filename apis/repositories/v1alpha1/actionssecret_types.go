@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ActionsSecretParameters defines the desired state of a GitHub Actions secret
+// of a GitHub Repository.
+type ActionsSecretParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The name of the secret. It can only contain alphanumeric characters
+	// and underscores, and is converted to upper case by GitHub.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// ValueSecretRef references the key of a Secret that holds the value of
+	// the secret. GitHub never returns it, a change of its value is detected
+	// by the version of the Secret it is read from.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
+// ActionsSecretSpec defines the desired state of an ActionsSecret.
+type ActionsSecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ActionsSecretParameters `json:"forProvider"`
+}
+
+// ActionsSecretObservation is the representation of the current state that is
+// observed
+type ActionsSecretObservation struct {
+	// CreatedAt is the time the secret was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the value of the secret was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// ActionsSecretStatus represents the observed state of an ActionsSecret.
+type ActionsSecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ActionsSecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ActionsSecret is a managed resource that represents a GitHub Actions
+// secret of a GitHub Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type ActionsSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ActionsSecretSpec   `json:"spec"`
+	Status ActionsSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ActionsSecretList contains a list of ActionsSecret
+type ActionsSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ActionsSecret `json:"items"`
+}
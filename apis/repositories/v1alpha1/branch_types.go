@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BranchParameters defines the desired state of a branch of a GitHub
+// Repository.
+type BranchParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The name of the branch.
+	// +immutable
+	Name string `json:"name"`
+
+	// The branch the branch is created from. The default branch of the
+	// Repository is used if neither it nor SourceSHA is set.
+	// +optional
+	// +immutable
+	SourceBranch *string `json:"sourceBranch,omitempty"`
+
+	// The SHA of the commit the branch is created from. It takes precedence
+	// over SourceBranch.
+	// +optional
+	// +immutable
+	SourceSHA *string `json:"sourceSha,omitempty"`
+}
+
+// BranchSpec defines the desired state of a Branch.
+type BranchSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BranchParameters `json:"forProvider"`
+}
+
+// BranchObservation is the representation of the current state that is
+// observed
+type BranchObservation struct {
+	// The fully qualified name of the reference of the branch.
+	Ref string `json:"ref,omitempty"`
+
+	// The SHA of the commit the branch points to.
+	SHA string `json:"sha,omitempty"`
+
+	// The API URL of the reference of the branch.
+	URL string `json:"url,omitempty"`
+}
+
+// BranchStatus represents the observed state of a Branch.
+type BranchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Branch is a managed resource that represents a branch of a GitHub
+// Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type Branch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchSpec   `json:"spec"`
+	Status BranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchList contains a list of Branch
+type BranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Branch `json:"items"`
+}
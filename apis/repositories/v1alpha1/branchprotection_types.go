@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RequiredStatusChecks are the status checks that must pass before branches
+// can be merged into the protected branch.
+type RequiredStatusChecks struct {
+	// Require branches to be up to date before merging.
+	Strict bool `json:"strict"`
+
+	// The list of status checks to require in order to merge into the
+	// branch.
+	// +optional
+	Contexts []string `json:"contexts,omitempty"`
+}
+
+// RequiredPullRequestReviews are the reviews pull requests need before they
+// can be merged into the protected branch.
+type RequiredPullRequestReviews struct {
+	// Whether approving reviews are dismissed when someone pushes a new
+	// commit.
+	// +optional
+	DismissStaleReviews *bool `json:"dismissStaleReviews,omitempty"`
+
+	// Whether an approving review by a designated code owner is required
+	// for pull requests that modify the code they own.
+	// +optional
+	RequireCodeOwnerReviews *bool `json:"requireCodeOwnerReviews,omitempty"`
+
+	// The number of approving reviews required to merge a pull request.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=6
+	RequiredApprovingReviewCount *int `json:"requiredApprovingReviewCount,omitempty"`
+}
+
+// PushRestrictions restrict who can push to the protected branch. They are
+// only available for organization-owned repositories.
+type PushRestrictions struct {
+	// The logins of the users that can push.
+	// +optional
+	Users []string `json:"users,omitempty"`
+
+	// The slugs of the teams that can push.
+	// +optional
+	Teams []string `json:"teams,omitempty"`
+
+	// The slugs of the GitHub Apps that can push.
+	// +optional
+	Apps []string `json:"apps,omitempty"`
+}
+
+// BranchProtectionParameters defines the desired state of the protection of a
+// branch of a GitHub Repository.
+type BranchProtectionParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The name of the branch to protect, or a pattern such as release/* that
+	// protects every matching branch. Patterns are managed as branch
+	// protection rules through the GraphQL API.
+	// +immutable
+	Branch string `json:"branch"`
+
+	// Require status checks to pass before merging.
+	// +optional
+	RequiredStatusChecks *RequiredStatusChecks `json:"requiredStatusChecks,omitempty"`
+
+	// Require pull request reviews before merging.
+	// +optional
+	RequiredPullRequestReviews *RequiredPullRequestReviews `json:"requiredPullRequestReviews,omitempty"`
+
+	// Enforce all configured restrictions for administrators.
+	// +optional
+	EnforceAdmins *bool `json:"enforceAdmins,omitempty"`
+
+	// Restrict who can push to the branch.
+	// +optional
+	Restrictions *PushRestrictions `json:"restrictions,omitempty"`
+
+	// Prevent merge commits from being pushed to the branch.
+	// +optional
+	RequireLinearHistory *bool `json:"requireLinearHistory,omitempty"`
+
+	// Permit force pushes to the branch by anyone with push access.
+	// +optional
+	AllowForcePushes *bool `json:"allowForcePushes,omitempty"`
+
+	// Allow anyone with push access to delete the branch.
+	// +optional
+	AllowDeletions *bool `json:"allowDeletions,omitempty"`
+}
+
+// BranchProtectionSpec defines the desired state of a BranchProtection.
+type BranchProtectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BranchProtectionParameters `json:"forProvider"`
+}
+
+// BranchProtectionObservation is the representation of the current state that
+// is observed
+type BranchProtectionObservation struct {
+	// The status checks that must pass before merging.
+	RequiredStatusChecks []string `json:"requiredStatusChecks,omitempty"`
+
+	// The number of approving reviews required to merge a pull request.
+	RequiredApprovingReviewCount int `json:"requiredApprovingReviewCount,omitempty"`
+
+	// Whether the restrictions are enforced for administrators.
+	EnforceAdmins bool `json:"enforceAdmins,omitempty"`
+}
+
+// BranchProtectionStatus represents the observed state of a BranchProtection.
+type BranchProtectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchProtectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BranchProtection is a managed resource that represents the protection of a
+// branch of a GitHub Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.branch"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type BranchProtection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchProtectionSpec   `json:"spec"`
+	Status BranchProtectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchProtectionList contains a list of BranchProtection
+type BranchProtectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BranchProtection `json:"items"`
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DeployKeyParameters defines the desired state of a deploy key of a GitHub
+// Repository. Deploy keys cannot be changed once they are added.
+type DeployKeyParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The title of the deploy key.
+	// +immutable
+	Title string `json:"title"`
+
+	// The public SSH key to add. If it is not given, an ed25519 key pair is
+	// generated and its private key is written to the connection Secret as
+	// privateKey, which is then required. The private key is not stored
+	// elsewhere; the deploy key is replaced if it is lost.
+	// +optional
+	// +immutable
+	Key *string `json:"key,omitempty"`
+
+	// Whether the key can only read the contents of the Repository.
+	// Default is true.
+	// +optional
+	// +immutable
+	ReadOnly *bool `json:"readOnly,omitempty"`
+}
+
+// DeployKeySpec defines the desired state of a DeployKey.
+type DeployKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DeployKeyParameters `json:"forProvider"`
+}
+
+// DeployKeyObservation is the representation of the current state that is
+// observed
+type DeployKeyObservation struct {
+	// The ID of the deploy key.
+	ID int64 `json:"id,omitempty"`
+
+	// The API URL of the deploy key.
+	URL string `json:"url,omitempty"`
+
+	// The SHA256 fingerprint of the public key.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// CreatedAt is the time the deploy key was added.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// DeployKeyStatus represents the observed state of a DeployKey.
+type DeployKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DeployKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DeployKey is a managed resource that represents a deploy key of a GitHub
+// Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="READ-ONLY",type="boolean",JSONPath=".spec.forProvider.readOnly"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type DeployKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeployKeySpec   `json:"spec"`
+	Status DeployKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeployKeyList contains a list of DeployKey
+type DeployKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeployKey `json:"items"`
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// An EnvironmentReviewer is a user or a team who can approve deployments to
+// an environment.
+type EnvironmentReviewer struct {
+	// The login of a user. Either it or Team is required.
+	// +optional
+	User *string `json:"user,omitempty"`
+
+	// MembershipRef references a Membership to retrieve the login of its
+	// user.
+	// +optional
+	MembershipRef *xpv1.Reference `json:"membershipRef,omitempty"`
+
+	// The slug of a team of the organization that owns the Repository.
+	// +optional
+	Team *string `json:"team,omitempty"`
+
+	// TeamRef references a Team to retrieve its slug.
+	// +optional
+	TeamRef *xpv1.Reference `json:"teamRef,omitempty"`
+}
+
+// A DeploymentBranchPattern is a name pattern of the branches or tags that
+// can deploy to an environment.
+type DeploymentBranchPattern struct {
+	// The name pattern, for example release/*.
+	Name string `json:"name"`
+
+	// Whether the pattern matches branches or tags. Can be branch or tag.
+	// Default is "branch".
+	// +optional
+	// +kubebuilder:validation:Enum=branch;tag
+	Type *string `json:"type,omitempty"`
+}
+
+// DeploymentBranchPolicy defines which branches and tags can deploy to an
+// environment.
+type DeploymentBranchPolicy struct {
+	// Whether only branches with branch protection rules can deploy.
+	// Otherwise only the branches and tags that match Patterns can.
+	ProtectedBranches bool `json:"protectedBranches"`
+
+	// The name patterns of the branches and tags that can deploy when
+	// ProtectedBranches is false.
+	// +optional
+	Patterns []DeploymentBranchPattern `json:"patterns,omitempty"`
+}
+
+// EnvironmentParameters defines the desired state of a deployment environment
+// of a GitHub Repository.
+type EnvironmentParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The name of the environment.
+	// +immutable
+	Name string `json:"name"`
+
+	// The number of minutes to wait before deployments to the environment
+	// proceed.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=43200
+	WaitTimer *int `json:"waitTimer,omitempty"`
+
+	// The users and teams who can approve deployments to the environment.
+	// A deployment waits for the approval of one of them.
+	// +optional
+	// +kubebuilder:validation:MaxItems=6
+	Reviewers []EnvironmentReviewer `json:"reviewers,omitempty"`
+
+	// Whether the user who triggered a deployment is prevented from
+	// approving it.
+	// +optional
+	PreventSelfReview *bool `json:"preventSelfReview,omitempty"`
+
+	// DeploymentBranchPolicy defines which branches and tags can deploy to
+	// the environment. All can if it is not set.
+	// +optional
+	DeploymentBranchPolicy *DeploymentBranchPolicy `json:"deploymentBranchPolicy,omitempty"`
+}
+
+// EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentParameters `json:"forProvider"`
+}
+
+// EnvironmentObservation is the representation of the current state that is
+// observed
+type EnvironmentObservation struct {
+	// The ID of the environment.
+	ID int64 `json:"id,omitempty"`
+
+	// The NodeID of the environment.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// The API URL of the environment.
+	URL string `json:"url,omitempty"`
+
+	// The URL of the environment on GitHub.
+	HTMLURL string `json:"htmlUrl,omitempty"`
+
+	// CreatedAt is the time the environment was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the environment was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Environment is a managed resource that represents a deployment
+// environment of a GitHub Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
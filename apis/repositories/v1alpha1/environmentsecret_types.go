@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvironmentSecretParameters defines the desired state of a GitHub Actions
+// secret of a deployment environment.
+type EnvironmentSecretParameters struct {
+	// The ID of the Repository. Environment secrets are addressed by the ID
+	// of their Repository rather than by its name.
+	// +optional
+	// +immutable
+	RepositoryID *int64 `json:"repositoryId,omitempty"`
+
+	// RepositoryIDRef references a Repository to retrieve its ID.
+	// +optional
+	RepositoryIDRef *xpv1.Reference `json:"repositoryIdRef,omitempty"`
+
+	// RepositoryIDSelector selects a reference to a Repository to retrieve
+	// its ID.
+	// +optional
+	RepositoryIDSelector *xpv1.Selector `json:"repositoryIdSelector,omitempty"`
+
+	// The name of the environment.
+	// +optional
+	// +immutable
+	Environment string `json:"environment,omitempty"`
+
+	// EnvironmentRef references an Environment to retrieve its name.
+	// +optional
+	EnvironmentRef *xpv1.Reference `json:"environmentRef,omitempty"`
+
+	// EnvironmentSelector selects a reference to an Environment to retrieve
+	// its name.
+	// +optional
+	EnvironmentSelector *xpv1.Selector `json:"environmentSelector,omitempty"`
+
+	// The name of the secret. It can only contain alphanumeric characters
+	// and underscores, and is converted to upper case by GitHub.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// ValueSecretRef references the key of a Secret that holds the value of
+	// the secret. GitHub never returns it, a change of its value is detected
+	// by the version of the Secret it is read from.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
+// EnvironmentSecretSpec defines the desired state of an EnvironmentSecret.
+type EnvironmentSecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentSecretParameters `json:"forProvider"`
+}
+
+// EnvironmentSecretObservation is the representation of the current state
+// that is observed
+type EnvironmentSecretObservation struct {
+	// CreatedAt is the time the secret was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the value of the secret was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// EnvironmentSecretStatus represents the observed state of an
+// EnvironmentSecret.
+type EnvironmentSecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentSecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EnvironmentSecret is a managed resource that represents a GitHub Actions
+// secret of a deployment environment of a GitHub Repository
+// +kubebuilder:printcolumn:name="ENVIRONMENT",type="string",JSONPath=".spec.forProvider.environment"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type EnvironmentSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSecretSpec   `json:"spec"`
+	Status EnvironmentSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentSecretList contains a list of EnvironmentSecret
+type EnvironmentSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvironmentSecret `json:"items"`
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvironmentVariableParameters defines the desired state of a GitHub Actions
+// configuration variable of a deployment environment of a GitHub Repository.
+type EnvironmentVariableParameters struct {
+	// The ID of the Repository. Environment variables are addressed by the
+	// ID of their Repository rather than by its name, like environment
+	// secrets.
+	// +optional
+	// +immutable
+	RepositoryID *int64 `json:"repositoryId,omitempty"`
+
+	// RepositoryIDRef references a Repository to retrieve its ID.
+	// +optional
+	RepositoryIDRef *xpv1.Reference `json:"repositoryIdRef,omitempty"`
+
+	// RepositoryIDSelector selects a reference to a Repository to retrieve
+	// its ID.
+	// +optional
+	RepositoryIDSelector *xpv1.Selector `json:"repositoryIdSelector,omitempty"`
+
+	// The name of the environment.
+	// +optional
+	// +immutable
+	Environment string `json:"environment,omitempty"`
+
+	// EnvironmentRef references an Environment to retrieve its name.
+	// +optional
+	EnvironmentRef *xpv1.Reference `json:"environmentRef,omitempty"`
+
+	// EnvironmentSelector selects a reference to an Environment to retrieve
+	// its name.
+	// +optional
+	EnvironmentSelector *xpv1.Selector `json:"environmentSelector,omitempty"`
+
+	// The name of the variable. It can only contain alphanumeric characters
+	// and underscores, and is converted to upper case by GitHub.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// The value of the variable. Either it or ValueConfigMapRef is required.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueConfigMapRef references the key of a ConfigMap that holds the
+	// value of the variable.
+	// +optional
+	ValueConfigMapRef *ConfigMapKeySelector `json:"valueConfigMapRef,omitempty"`
+}
+
+// EnvironmentVariableSpec defines the desired state of an EnvironmentVariable.
+type EnvironmentVariableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentVariableParameters `json:"forProvider"`
+}
+
+// EnvironmentVariableObservation is the representation of the current state
+// that is observed
+type EnvironmentVariableObservation struct {
+	// CreatedAt is the time the variable was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the variable was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// EnvironmentVariableStatus represents the observed state of an
+// EnvironmentVariable.
+type EnvironmentVariableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentVariableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EnvironmentVariable is a managed resource that represents a GitHub
+// Actions configuration variable of a deployment environment
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="ENVIRONMENT",type="string",JSONPath=".spec.forProvider.environment"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type EnvironmentVariable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentVariableSpec   `json:"spec"`
+	Status EnvironmentVariableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentVariableList contains a list of EnvironmentVariable
+type EnvironmentVariableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvironmentVariable `json:"items"`
+}
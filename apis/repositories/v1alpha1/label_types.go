@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LabelParameters defines the desired state of a label of a GitHub
+// Repository.
+type LabelParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The name of the label. The external name of the Label is the name the
+	// label currently has, the label is renamed if they differ.
+	Name string `json:"name"`
+
+	// The hexadecimal color code of the label, without the leading #.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{6}$`
+	Color string `json:"color"`
+
+	// A short description of the label.
+	// +optional
+	// +kubebuilder:validation:MaxLength=100
+	Description *string `json:"description,omitempty"`
+}
+
+// LabelSpec defines the desired state of a Label.
+type LabelSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LabelParameters `json:"forProvider"`
+}
+
+// LabelObservation is the representation of the current state that is
+// observed
+type LabelObservation struct {
+	// The ID of the label.
+	ID int64 `json:"id,omitempty"`
+
+	// The NodeID of the label.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// The API URL of the label.
+	URL string `json:"url,omitempty"`
+
+	// Whether the label is one of the default labels of the Repository.
+	Default bool `json:"default,omitempty"`
+}
+
+// LabelStatus represents the observed state of a Label.
+type LabelStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LabelObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Label is a managed resource that represents a label of a GitHub
+// Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type Label struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LabelSpec   `json:"spec"`
+	Status LabelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LabelList contains a list of Label
+type LabelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Label `json:"items"`
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A LabelSetLabel is a label of a LabelSet.
+type LabelSetLabel struct {
+	// The name of the label. Labels are matched by name regardless of case,
+	// an existing label whose name only differs in case is renamed.
+	Name string `json:"name"`
+
+	// The hexadecimal color code of the label, without the leading #.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{6}$`
+	Color string `json:"color"`
+
+	// A short description of the label. The description of an existing
+	// label is kept if it is not set.
+	// +optional
+	// +kubebuilder:validation:MaxLength=100
+	Description *string `json:"description,omitempty"`
+}
+
+// LabelSetParameters defines the desired set of labels of a GitHub
+// Repository.
+type LabelSetParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The labels of the Repository. Only the first of the labels whose
+	// names differ only in case is managed. Labels the Repository already
+	// has, like the default labels GitHub creates, are managed as well, so
+	// the labels are kept when the LabelSet is deleted unless DeleteLabels
+	// is true.
+	// +kubebuilder:validation:MinItems=1
+	Labels []LabelSetLabel `json:"labels"`
+
+	// Whether the labels of the Repository that are not in Labels are
+	// deleted. They are kept by default.
+	// +optional
+	DeleteUnmanaged *bool `json:"deleteUnmanaged,omitempty"`
+
+	// Whether the labels in Labels are deleted when the LabelSet is. They
+	// are kept by default.
+	// +optional
+	DeleteLabels *bool `json:"deleteLabels,omitempty"`
+}
+
+// LabelSetSpec defines the desired state of a LabelSet.
+type LabelSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LabelSetParameters `json:"forProvider"`
+}
+
+// LabelSetObservation is the representation of the current state that is
+// observed
+type LabelSetObservation struct {
+	// The number of labels of the set the Repository has.
+	ManagedLabels int `json:"managedLabels,omitempty"`
+
+	// The names of the labels of the Repository that are not in the set.
+	UnmanagedLabels []string `json:"unmanagedLabels,omitempty"`
+}
+
+// LabelSetStatus represents the observed state of a LabelSet.
+type LabelSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LabelSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LabelSet is a managed resource that represents the labels of a GitHub
+// Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type LabelSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LabelSetSpec   `json:"spec"`
+	Status LabelSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LabelSetList contains a list of LabelSet
+type LabelSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LabelSet `json:"items"`
+}
@@ -0,0 +1,496 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errGetReferenced = "cannot get referenced resource"
+	errEmptyValue    = "referenced field was empty (referenced resource may not yet be ready)"
+)
+
+// Teams and Memberships are organizations resources. They are referenced as
+// unstructured objects because the organizations API group imports this one.
+var (
+	teamGVK       = schema.GroupVersionKind{Group: "organizations.github.crossplane.io", Version: "v1alpha1", Kind: "Team"}
+	membershipGVK = schema.GroupVersionKind{Group: "organizations.github.crossplane.io", Version: "v1alpha1", Kind: "Membership"}
+)
+
+// RepositoryID extracts the ID of a Repository.
+func RepositoryID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Repository)
+		if !ok || r.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.FormatInt(r.Status.AtProvider.ID, 10)
+	}
+}
+
+// EnvironmentName extracts the name of an Environment once it exists.
+func EnvironmentName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		e, ok := mg.(*Environment)
+		if !ok || e.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return e.Spec.ForProvider.Name
+	}
+}
+
+// getReferenced gets the referenced resource of the supplied kind.
+func getReferenced(ctx context.Context, c client.Reader, gvk schema.GroupVersionKind, ref xpv1.Reference) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, u); err != nil {
+		return nil, errors.Wrap(err, errGetReferenced)
+	}
+	return u, nil
+}
+
+// resolveTeamSlug returns the slug of the referenced Team. It is only known
+// once the Team was observed.
+func resolveTeamSlug(ctx context.Context, c client.Reader, ref xpv1.Reference) (string, error) {
+	u, err := getReferenced(ctx, c, teamGVK, ref)
+	if err != nil {
+		return "", err
+	}
+	slug, _, _ := unstructured.NestedString(u.Object, "status", "atProvider", "slug")
+	if slug == "" {
+		return "", errors.New(errEmptyValue)
+	}
+	return slug, nil
+}
+
+// resolveActiveMembershipUser returns the username of the referenced
+// Membership once the user accepted the invitation to the organization.
+func resolveActiveMembershipUser(ctx context.Context, c client.Reader, ref xpv1.Reference) (string, error) {
+	u, err := getReferenced(ctx, c, membershipGVK, ref)
+	if err != nil {
+		return "", err
+	}
+	state, _, _ := unstructured.NestedString(u.Object, "status", "atProvider", "state")
+	user, _, _ := unstructured.NestedString(u.Object, "spec", "forProvider", "user")
+	if state != "active" || user == "" {
+		return "", errors.New(errEmptyValue)
+	}
+	return user, nil
+}
+
+// ResolveReferences of this RepositoryCollaborator.
+func (mg *RepositoryCollaborator) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this BranchProtection.
+func (mg *BranchProtection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RepositoryRuleset.
+func (mg *RepositoryRuleset) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RepositoryWebhook.
+func (mg *RepositoryWebhook) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DeployKey.
+func (mg *DeployKey) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ActionsSecret.
+func (mg *ActionsSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DependabotSecret.
+func (mg *DependabotSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CodespacesSecret.
+func (mg *CodespacesSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ActionsVariable.
+func (mg *ActionsVariable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EnvironmentVariable.
+func (mg *EnvironmentVariable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromInt64Ptr(mg.Spec.ForProvider.RepositoryID),
+		Reference:    mg.Spec.ForProvider.RepositoryIDRef,
+		Selector:     mg.Spec.ForProvider.RepositoryIDSelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      RepositoryID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repositoryId")
+	}
+	id, err := toInt64Ptr(rsp.ResolvedValue)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repositoryId")
+	}
+	mg.Spec.ForProvider.RepositoryID = id
+	mg.Spec.ForProvider.RepositoryIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Environment,
+		Reference:    mg.Spec.ForProvider.EnvironmentRef,
+		Selector:     mg.Spec.ForProvider.EnvironmentSelector,
+		To:           reference.To{Managed: &Environment{}, List: &EnvironmentList{}},
+		Extract:      EnvironmentName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.environment")
+	}
+	mg.Spec.ForProvider.Environment = rsp.ResolvedValue
+	mg.Spec.ForProvider.EnvironmentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Environment.
+func (mg *Environment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.Reviewers {
+		rv := &mg.Spec.ForProvider.Reviewers[i]
+		if rv.User == nil && rv.MembershipRef != nil {
+			user, err := resolveActiveMembershipUser(ctx, c, *rv.MembershipRef)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.reviewers[%d].user", i))
+			}
+			rv.User = &user
+		}
+		if rv.Team == nil && rv.TeamRef != nil {
+			slug, err := resolveTeamSlug(ctx, c, *rv.TeamRef)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.reviewers[%d].team", i))
+			}
+			rv.Team = &slug
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this EnvironmentSecret.
+func (mg *EnvironmentSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromInt64Ptr(mg.Spec.ForProvider.RepositoryID),
+		Reference:    mg.Spec.ForProvider.RepositoryIDRef,
+		Selector:     mg.Spec.ForProvider.RepositoryIDSelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      RepositoryID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repositoryId")
+	}
+	id, err := toInt64Ptr(rsp.ResolvedValue)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repositoryId")
+	}
+	mg.Spec.ForProvider.RepositoryID = id
+	mg.Spec.ForProvider.RepositoryIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Environment,
+		Reference:    mg.Spec.ForProvider.EnvironmentRef,
+		Selector:     mg.Spec.ForProvider.EnvironmentSelector,
+		To:           reference.To{Managed: &Environment{}, List: &EnvironmentList{}},
+		Extract:      EnvironmentName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.environment")
+	}
+	mg.Spec.ForProvider.Environment = rsp.ResolvedValue
+	mg.Spec.ForProvider.EnvironmentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+func fromInt64Ptr(i *int64) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.FormatInt(*i, 10)
+}
+
+func toInt64Ptr(s string) (*int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// ResolveReferences of this Label.
+func (mg *Label) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this LabelSet.
+func (mg *LabelSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RepositoryFile.
+func (mg *RepositoryFile) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Branch.
+func (mg *Branch) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repository,
+		Reference:    mg.Spec.ForProvider.RepositoryRef,
+		Selector:     mg.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	mg.Spec.ForProvider.Repository = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	return nil
+}
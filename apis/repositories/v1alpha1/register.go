@@ -45,6 +45,150 @@ var (
 	RepositoryGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryKind)
 )
 
+// RepositoryCollaborator type metadata.
+var (
+	RepositoryCollaboratorKind             = reflect.TypeOf(RepositoryCollaborator{}).Name()
+	RepositoryCollaboratorGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryCollaboratorKind}.String()
+	RepositoryCollaboratorKindAPIVersion   = RepositoryCollaboratorKind + "." + SchemeGroupVersion.String()
+	RepositoryCollaboratorGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryCollaboratorKind)
+)
+
+// BranchProtection type metadata.
+var (
+	BranchProtectionKind             = reflect.TypeOf(BranchProtection{}).Name()
+	BranchProtectionGroupKind        = schema.GroupKind{Group: Group, Kind: BranchProtectionKind}.String()
+	BranchProtectionKindAPIVersion   = BranchProtectionKind + "." + SchemeGroupVersion.String()
+	BranchProtectionGroupVersionKind = SchemeGroupVersion.WithKind(BranchProtectionKind)
+)
+
+// RepositoryRuleset type metadata.
+var (
+	RepositoryRulesetKind             = reflect.TypeOf(RepositoryRuleset{}).Name()
+	RepositoryRulesetGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryRulesetKind}.String()
+	RepositoryRulesetKindAPIVersion   = RepositoryRulesetKind + "." + SchemeGroupVersion.String()
+	RepositoryRulesetGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryRulesetKind)
+)
+
+// RepositoryWebhook type metadata.
+var (
+	RepositoryWebhookKind             = reflect.TypeOf(RepositoryWebhook{}).Name()
+	RepositoryWebhookGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryWebhookKind}.String()
+	RepositoryWebhookKindAPIVersion   = RepositoryWebhookKind + "." + SchemeGroupVersion.String()
+	RepositoryWebhookGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryWebhookKind)
+)
+
+// DeployKey type metadata.
+var (
+	DeployKeyKind             = reflect.TypeOf(DeployKey{}).Name()
+	DeployKeyGroupKind        = schema.GroupKind{Group: Group, Kind: DeployKeyKind}.String()
+	DeployKeyKindAPIVersion   = DeployKeyKind + "." + SchemeGroupVersion.String()
+	DeployKeyGroupVersionKind = SchemeGroupVersion.WithKind(DeployKeyKind)
+)
+
+// ActionsSecret type metadata.
+var (
+	ActionsSecretKind             = reflect.TypeOf(ActionsSecret{}).Name()
+	ActionsSecretGroupKind        = schema.GroupKind{Group: Group, Kind: ActionsSecretKind}.String()
+	ActionsSecretKindAPIVersion   = ActionsSecretKind + "." + SchemeGroupVersion.String()
+	ActionsSecretGroupVersionKind = SchemeGroupVersion.WithKind(ActionsSecretKind)
+)
+
+// ActionsVariable type metadata.
+var (
+	ActionsVariableKind             = reflect.TypeOf(ActionsVariable{}).Name()
+	ActionsVariableGroupKind        = schema.GroupKind{Group: Group, Kind: ActionsVariableKind}.String()
+	ActionsVariableKindAPIVersion   = ActionsVariableKind + "." + SchemeGroupVersion.String()
+	ActionsVariableGroupVersionKind = SchemeGroupVersion.WithKind(ActionsVariableKind)
+)
+
+// EnvironmentVariable type metadata.
+var (
+	EnvironmentVariableKind             = reflect.TypeOf(EnvironmentVariable{}).Name()
+	EnvironmentVariableGroupKind        = schema.GroupKind{Group: Group, Kind: EnvironmentVariableKind}.String()
+	EnvironmentVariableKindAPIVersion   = EnvironmentVariableKind + "." + SchemeGroupVersion.String()
+	EnvironmentVariableGroupVersionKind = SchemeGroupVersion.WithKind(EnvironmentVariableKind)
+)
+
+// Environment type metadata.
+var (
+	EnvironmentKind             = reflect.TypeOf(Environment{}).Name()
+	EnvironmentGroupKind        = schema.GroupKind{Group: Group, Kind: EnvironmentKind}.String()
+	EnvironmentKindAPIVersion   = EnvironmentKind + "." + SchemeGroupVersion.String()
+	EnvironmentGroupVersionKind = SchemeGroupVersion.WithKind(EnvironmentKind)
+)
+
+// EnvironmentSecret type metadata.
+var (
+	EnvironmentSecretKind             = reflect.TypeOf(EnvironmentSecret{}).Name()
+	EnvironmentSecretGroupKind        = schema.GroupKind{Group: Group, Kind: EnvironmentSecretKind}.String()
+	EnvironmentSecretKindAPIVersion   = EnvironmentSecretKind + "." + SchemeGroupVersion.String()
+	EnvironmentSecretGroupVersionKind = SchemeGroupVersion.WithKind(EnvironmentSecretKind)
+)
+
+// DependabotSecret type metadata.
+var (
+	DependabotSecretKind             = reflect.TypeOf(DependabotSecret{}).Name()
+	DependabotSecretGroupKind        = schema.GroupKind{Group: Group, Kind: DependabotSecretKind}.String()
+	DependabotSecretKindAPIVersion   = DependabotSecretKind + "." + SchemeGroupVersion.String()
+	DependabotSecretGroupVersionKind = SchemeGroupVersion.WithKind(DependabotSecretKind)
+)
+
+// CodespacesSecret type metadata.
+var (
+	CodespacesSecretKind             = reflect.TypeOf(CodespacesSecret{}).Name()
+	CodespacesSecretGroupKind        = schema.GroupKind{Group: Group, Kind: CodespacesSecretKind}.String()
+	CodespacesSecretKindAPIVersion   = CodespacesSecretKind + "." + SchemeGroupVersion.String()
+	CodespacesSecretGroupVersionKind = SchemeGroupVersion.WithKind(CodespacesSecretKind)
+)
+
+// Label type metadata.
+var (
+	LabelKind             = reflect.TypeOf(Label{}).Name()
+	LabelGroupKind        = schema.GroupKind{Group: Group, Kind: LabelKind}.String()
+	LabelKindAPIVersion   = LabelKind + "." + SchemeGroupVersion.String()
+	LabelGroupVersionKind = SchemeGroupVersion.WithKind(LabelKind)
+)
+
+// LabelSet type metadata.
+var (
+	LabelSetKind             = reflect.TypeOf(LabelSet{}).Name()
+	LabelSetGroupKind        = schema.GroupKind{Group: Group, Kind: LabelSetKind}.String()
+	LabelSetKindAPIVersion   = LabelSetKind + "." + SchemeGroupVersion.String()
+	LabelSetGroupVersionKind = SchemeGroupVersion.WithKind(LabelSetKind)
+)
+
+// RepositoryFile type metadata.
+var (
+	RepositoryFileKind             = reflect.TypeOf(RepositoryFile{}).Name()
+	RepositoryFileGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryFileKind}.String()
+	RepositoryFileKindAPIVersion   = RepositoryFileKind + "." + SchemeGroupVersion.String()
+	RepositoryFileGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryFileKind)
+)
+
+// Branch type metadata.
+var (
+	BranchKind             = reflect.TypeOf(Branch{}).Name()
+	BranchGroupKind        = schema.GroupKind{Group: Group, Kind: BranchKind}.String()
+	BranchKindAPIVersion   = BranchKind + "." + SchemeGroupVersion.String()
+	BranchGroupVersionKind = SchemeGroupVersion.WithKind(BranchKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Repository{}, &RepositoryList{})
+	SchemeBuilder.Register(&RepositoryCollaborator{}, &RepositoryCollaboratorList{})
+	SchemeBuilder.Register(&BranchProtection{}, &BranchProtectionList{})
+	SchemeBuilder.Register(&RepositoryRuleset{}, &RepositoryRulesetList{})
+	SchemeBuilder.Register(&RepositoryWebhook{}, &RepositoryWebhookList{})
+	SchemeBuilder.Register(&DeployKey{}, &DeployKeyList{})
+	SchemeBuilder.Register(&ActionsSecret{}, &ActionsSecretList{})
+	SchemeBuilder.Register(&ActionsVariable{}, &ActionsVariableList{})
+	SchemeBuilder.Register(&EnvironmentVariable{}, &EnvironmentVariableList{})
+	SchemeBuilder.Register(&Environment{}, &EnvironmentList{})
+	SchemeBuilder.Register(&EnvironmentSecret{}, &EnvironmentSecretList{})
+	SchemeBuilder.Register(&DependabotSecret{}, &DependabotSecretList{})
+	SchemeBuilder.Register(&CodespacesSecret{}, &CodespacesSecretList{})
+	SchemeBuilder.Register(&Label{}, &LabelList{})
+	SchemeBuilder.Register(&LabelSet{}, &LabelSetList{})
+	SchemeBuilder.Register(&RepositoryFile{}, &RepositoryFileList{})
+	SchemeBuilder.Register(&Branch{}, &BranchList{})
 }
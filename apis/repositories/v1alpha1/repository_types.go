@@ -140,7 +140,7 @@ type RepositoryParameters struct {
 	HasDownloads *bool `json:"hasDownloads,omitempty"`
 
 	// Name of the default branch
-	// The branch must already exist in the repository.
+	// The branch must already exist in the repository, a Branch can create it.
 	// +optional
 	DefaultBranch *string `json:"defaultBranch,omitempty"`
 
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Possible states of a RepositoryCollaborator.
+const (
+	// CollaboratorStateActive means the user is a collaborator.
+	CollaboratorStateActive = "active"
+
+	// CollaboratorStatePending means the user was invited and did not
+	// accept the invitation yet.
+	CollaboratorStatePending = "pending"
+
+	// CollaboratorStateExpired means the user did not accept the invitation
+	// in time. A new invitation is sent.
+	CollaboratorStateExpired = "expired"
+)
+
+// RepositoryCollaboratorParameters defines the desired state of a collaborator
+// on a GitHub Repository.
+type RepositoryCollaboratorParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// User is the username of the GitHub user.
+	// +immutable
+	User string `json:"user"`
+
+	// The permission to grant the collaborator. Can be one of pull, triage,
+	// push, maintain, admin, or the name of a custom repository role defined
+	// by the organization. Only valid on organization-owned repositories.
+	// Default is "push".
+	// +optional
+	Permission *string `json:"permission,omitempty"`
+}
+
+// RepositoryCollaboratorSpec defines the desired state of a
+// RepositoryCollaborator.
+type RepositoryCollaboratorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryCollaboratorParameters `json:"forProvider"`
+}
+
+// RepositoryCollaboratorObservation is the representation of the current state
+// that is observed
+type RepositoryCollaboratorObservation struct {
+	// State of the collaborator. Can be one of active, pending or expired.
+	State string `json:"state,omitempty"`
+
+	// The permission the collaborator currently has, or was invited with.
+	Permission string `json:"permission,omitempty"`
+
+	// The ID of the invitation sent to the user, until it is accepted.
+	InvitationID int64 `json:"invitationId,omitempty"`
+
+	// The URL at which the user can accept the invitation.
+	InvitationURL string `json:"invitationUrl,omitempty"`
+}
+
+// RepositoryCollaboratorStatus represents the observed state of a
+// RepositoryCollaborator.
+type RepositoryCollaboratorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryCollaboratorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryCollaborator is a managed resource that represents a collaborator
+// on a GitHub Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.forProvider.user"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type RepositoryCollaborator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryCollaboratorSpec   `json:"spec"`
+	Status RepositoryCollaboratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryCollaboratorList contains a list of RepositoryCollaborator
+type RepositoryCollaboratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryCollaborator `json:"items"`
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A CommitAuthor is the author of the commits of a RepositoryFile.
+type CommitAuthor struct {
+	// The name of the author.
+	Name string `json:"name"`
+
+	// The email of the author.
+	Email string `json:"email"`
+}
+
+// RepositoryFileParameters defines the desired state of a file of a GitHub
+// Repository.
+type RepositoryFileParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The path of the file in the Repository.
+	// +immutable
+	Path string `json:"path"`
+
+	// The branch the file is committed to. The default branch of the
+	// Repository is used if it is not set.
+	// +optional
+	// +immutable
+	Branch *string `json:"branch,omitempty"`
+
+	// The content of the file. Either it or ContentConfigMapRef is required.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentConfigMapRef references the key of a ConfigMap that holds the
+	// content of the file.
+	// +optional
+	ContentConfigMapRef *ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// The message of the commits that create, update and delete the file.
+	// A message naming the file is used if it is not set.
+	// +optional
+	CommitMessage *string `json:"commitMessage,omitempty"`
+
+	// The author of the commits. The authenticated user or app is the
+	// author if it is not set.
+	// +optional
+	CommitAuthor *CommitAuthor `json:"commitAuthor,omitempty"`
+
+	// Whether changes made to the file after it was last committed by
+	// Crossplane are overwritten. A file Crossplane did not commit is never
+	// overwritten if it is false, and a changed file is not deleted when the
+	// RepositoryFile is. Defaults to true.
+	// +optional
+	OverwriteChanges *bool `json:"overwriteChanges,omitempty"`
+}
+
+// RepositoryFileSpec defines the desired state of a RepositoryFile.
+type RepositoryFileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryFileParameters `json:"forProvider"`
+}
+
+// RepositoryFileObservation is the representation of the current state that
+// is observed
+type RepositoryFileObservation struct {
+	// The SHA of the blob of the file.
+	SHA string `json:"sha,omitempty"`
+
+	// The size of the file in bytes.
+	Size int `json:"size,omitempty"`
+
+	// The URL of the file.
+	HTMLURL string `json:"htmlUrl,omitempty"`
+
+	// Whether the file changed after it was last committed by Crossplane.
+	Modified bool `json:"modified,omitempty"`
+}
+
+// RepositoryFileStatus represents the observed state of a RepositoryFile.
+type RepositoryFileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryFileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryFile is a managed resource that represents a file of a GitHub
+// Repository. A file that already has the desired content is adopted, so it
+// is deleted with the RepositoryFile as if the RepositoryFile had committed
+// it. A file that was changed after it was last committed is kept when the
+// RepositoryFile is deleted, unless changes are overwritten.
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="PATH",type="string",JSONPath=".spec.forProvider.path"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type RepositoryFile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryFileSpec   `json:"spec"`
+	Status RepositoryFileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryFileList contains a list of RepositoryFile
+type RepositoryFileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryFile `json:"items"`
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RepositoryRulesetConditions select the refs a RepositoryRuleset applies to.
+type RepositoryRulesetConditions struct {
+	// RefName selects the branches or tags by name.
+	// +optional
+	RefName *RulesetRefNameCondition `json:"refName,omitempty"`
+}
+
+// RepositoryRulesetParameters defines the desired state of a ruleset of a
+// GitHub Repository.
+type RepositoryRulesetParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// The name of the ruleset.
+	Name string `json:"name"`
+
+	// The target of the ruleset. Can be branch or tag.
+	// Default is "branch".
+	// +optional
+	// +kubebuilder:validation:Enum=branch;tag
+	Target *string `json:"target,omitempty"`
+
+	// The enforcement level of the ruleset. Rules of an evaluate ruleset are
+	// not enforced, but their outcome is reported.
+	// +kubebuilder:validation:Enum=disabled;active;evaluate
+	Enforcement string `json:"enforcement"`
+
+	// The actors that can bypass the rules.
+	// +optional
+	BypassActors []RulesetBypassActor `json:"bypassActors,omitempty"`
+
+	// The refs the ruleset applies to.
+	// +optional
+	Conditions *RepositoryRulesetConditions `json:"conditions,omitempty"`
+
+	// The rules of the ruleset.
+	// +optional
+	Rules []RulesetRule `json:"rules,omitempty"`
+}
+
+// RepositoryRulesetSpec defines the desired state of a RepositoryRuleset.
+type RepositoryRulesetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryRulesetParameters `json:"forProvider"`
+}
+
+// RepositoryRulesetObservation is the representation of the current state that
+// is observed
+type RepositoryRulesetObservation struct {
+	// The ID of the ruleset.
+	ID int64 `json:"id,omitempty"`
+
+	// The NodeID of the ruleset.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// The type of the source of the ruleset.
+	SourceType string `json:"sourceType,omitempty"`
+
+	// The name of the source of the ruleset.
+	Source string `json:"source,omitempty"`
+}
+
+// RepositoryRulesetStatus represents the observed state of a
+// RepositoryRuleset.
+type RepositoryRulesetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryRulesetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryRuleset is a managed resource that represents a ruleset of a
+// GitHub Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="ENFORCEMENT",type="string",JSONPath=".spec.forProvider.enforcement"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type RepositoryRuleset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryRulesetSpec   `json:"spec"`
+	Status RepositoryRulesetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryRulesetList contains a list of RepositoryRuleset
+type RepositoryRulesetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryRuleset `json:"items"`
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WebhookParameters defines the desired state of a webhook that is shared by
+// the webhooks of Repositories and organizations.
+type WebhookParameters struct {
+	// The URL to which the payloads will be delivered.
+	URL string `json:"url"`
+
+	// The media type used to serialize the payloads. Can be json or form.
+	// Default is "form".
+	// +optional
+	// +kubebuilder:validation:Enum=json;form
+	ContentType *string `json:"contentType,omitempty"`
+
+	// SecretRef references the key of a Secret that holds the secret used
+	// to sign the payloads. GitHub never returns it, a change of its value
+	// is detected by the version of the Secret it is read from.
+	// +optional
+	SecretRef *xpv1.SecretKeySelector `json:"secretRef,omitempty"`
+
+	// Whether the SSL certificate of the host of the URL is not verified
+	// when payloads are delivered.
+	// Default is false.
+	// +optional
+	InsecureSSL *bool `json:"insecureSsl,omitempty"`
+
+	// The events the webhook is triggered for.
+	// Default is ["push"].
+	// +optional
+	Events []string `json:"events,omitempty"`
+
+	// Whether notifications are sent when the webhook is triggered.
+	// Default is true.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// RepositoryWebhookParameters defines the desired state of a webhook of a
+// GitHub Repository.
+type RepositoryWebhookParameters struct {
+	// The name of the Repository owner.
+	// The owner can be an organization or an user.
+	// +immutable
+	Owner string `json:"owner"`
+
+	// The name of the Repository.
+	// +optional
+	// +immutable
+	Repository string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to retrieve its name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository to retrieve its
+	// name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	WebhookParameters `json:",inline"`
+}
+
+// RepositoryWebhookSpec defines the desired state of a RepositoryWebhook.
+type RepositoryWebhookSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryWebhookParameters `json:"forProvider"`
+}
+
+// RepositoryWebhookObservation is the representation of the current state that
+// is observed
+type RepositoryWebhookObservation struct {
+	// The ID of the webhook.
+	ID int64 `json:"id,omitempty"`
+
+	// The API URL of the webhook.
+	URL string `json:"url,omitempty"`
+
+	// The URL that triggers a ping event to be sent to the webhook.
+	PingURL string `json:"pingUrl,omitempty"`
+
+	// The URL that triggers the webhook with the latest push to the
+	// Repository.
+	TestURL string `json:"testUrl,omitempty"`
+
+	// The status code of the response to the last delivery.
+	LastResponseCode int `json:"lastResponseCode,omitempty"`
+
+	// The status of the last delivery.
+	LastResponseStatus string `json:"lastResponseStatus,omitempty"`
+
+	// CreatedAt is the time the webhook was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the time the webhook was last updated.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// RepositoryWebhookStatus represents the observed state of a
+// RepositoryWebhook.
+type RepositoryWebhookStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryWebhookObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryWebhook is a managed resource that represents a webhook of a
+// GitHub Repository
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repository"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type RepositoryWebhook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryWebhookSpec   `json:"spec"`
+	Status RepositoryWebhookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryWebhookList contains a list of RepositoryWebhook
+type RepositoryWebhookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryWebhook `json:"items"`
+}
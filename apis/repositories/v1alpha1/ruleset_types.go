@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// RulesetBypassActor is an actor that can bypass the rules of a ruleset.
+type RulesetBypassActor struct {
+	// The ID of the actor. For example the ID of a Team, a GitHub App or a
+	// repository role.
+	// +optional
+	ActorID *int64 `json:"actorId,omitempty"`
+
+	// The type of the actor.
+	// +kubebuilder:validation:Enum=Integration;OrganizationAdmin;RepositoryRole;Team;DeployKey
+	ActorType string `json:"actorType"`
+
+	// When the actor can bypass the rules. Can be always or pull_request.
+	// Default is "always".
+	// +optional
+	// +kubebuilder:validation:Enum=always;pull_request
+	BypassMode *string `json:"bypassMode,omitempty"`
+}
+
+// RulesetRefNameCondition selects the branches or tags a ruleset applies to.
+type RulesetRefNameCondition struct {
+	// The ref name patterns to include. Accepts ~DEFAULT_BRANCH for the
+	// default branch and ~ALL for all branches.
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// The ref name patterns to exclude.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// RulesetUpdateParameters are the parameters of the update rule.
+type RulesetUpdateParameters struct {
+	// Whether branches can be updated by fetching and merging the upstream
+	// branch.
+	// +optional
+	UpdateAllowsFetchAndMerge bool `json:"updateAllowsFetchAndMerge,omitempty"`
+}
+
+// RulesetRequiredDeploymentsParameters are the parameters of the
+// required_deployments rule.
+type RulesetRequiredDeploymentsParameters struct {
+	// The environments that must be successfully deployed to before refs
+	// can be merged.
+	// +optional
+	Environments []string `json:"environments,omitempty"`
+}
+
+// RulesetPullRequestParameters are the parameters of the pull_request rule.
+type RulesetPullRequestParameters struct {
+	// Whether approving reviews are dismissed when someone pushes a new
+	// commit.
+	// +optional
+	DismissStaleReviewsOnPush bool `json:"dismissStaleReviewsOnPush,omitempty"`
+
+	// Whether an approving review by a designated code owner is required
+	// for pull requests that modify the code they own.
+	// +optional
+	RequireCodeOwnerReview bool `json:"requireCodeOwnerReview,omitempty"`
+
+	// Whether the most recent push must be approved by someone other than
+	// the person who pushed it.
+	// +optional
+	RequireLastPushApproval bool `json:"requireLastPushApproval,omitempty"`
+
+	// The number of approving reviews required to merge a pull request.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	RequiredApprovingReviewCount int `json:"requiredApprovingReviewCount,omitempty"`
+
+	// Whether all conversations on code must be resolved before a pull
+	// request can be merged.
+	// +optional
+	RequiredReviewThreadResolution bool `json:"requiredReviewThreadResolution,omitempty"`
+}
+
+// RulesetStatusCheck is a status check that must pass.
+type RulesetStatusCheck struct {
+	// The context of the status check.
+	Context string `json:"context"`
+
+	// The ID of the GitHub App that must provide the status check.
+	// +optional
+	IntegrationID *int64 `json:"integrationId,omitempty"`
+}
+
+// RulesetRequiredStatusChecksParameters are the parameters of the
+// required_status_checks rule.
+type RulesetRequiredStatusChecksParameters struct {
+	// The status checks that must pass before refs can be merged.
+	// +optional
+	StatusChecks []RulesetStatusCheck `json:"statusChecks,omitempty"`
+
+	// Whether branches must be up to date with the base branch before
+	// merging.
+	// +optional
+	StrictRequiredStatusChecksPolicy bool `json:"strictRequiredStatusChecksPolicy,omitempty"`
+}
+
+// RulesetPatternParameters are the parameters of the commit_message_pattern,
+// commit_author_email_pattern, committer_email_pattern, branch_name_pattern
+// and tag_name_pattern rules.
+type RulesetPatternParameters struct {
+	// How the rule is displayed.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Whether the rule fails if the pattern matches.
+	// +optional
+	Negate bool `json:"negate,omitempty"`
+
+	// How the pattern is matched.
+	// +kubebuilder:validation:Enum=starts_with;ends_with;contains;regex
+	Operator string `json:"operator"`
+
+	// The pattern to match.
+	Pattern string `json:"pattern"`
+}
+
+// A RulesetRule is a rule enforced by a repository or organization ruleset.
+// Only the parameters that belong to the type of the rule are used.
+type RulesetRule struct {
+	// The type of the rule.
+	// +kubebuilder:validation:Enum=creation;update;deletion;required_linear_history;required_deployments;required_signatures;pull_request;required_status_checks;non_fast_forward;commit_message_pattern;commit_author_email_pattern;committer_email_pattern;branch_name_pattern;tag_name_pattern
+	Type string `json:"type"`
+
+	// Parameters of the update rule.
+	// +optional
+	Update *RulesetUpdateParameters `json:"update,omitempty"`
+
+	// Parameters of the required_deployments rule.
+	// +optional
+	RequiredDeployments *RulesetRequiredDeploymentsParameters `json:"requiredDeployments,omitempty"`
+
+	// Parameters of the pull_request rule.
+	// +optional
+	PullRequest *RulesetPullRequestParameters `json:"pullRequest,omitempty"`
+
+	// Parameters of the required_status_checks rule.
+	// +optional
+	RequiredStatusChecks *RulesetRequiredStatusChecksParameters `json:"requiredStatusChecks,omitempty"`
+
+	// Parameters of the pattern rules.
+	// +optional
+	Pattern *RulesetPatternParameters `json:"pattern,omitempty"`
+}
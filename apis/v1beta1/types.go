@@ -26,6 +26,36 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// BaseURL of the GitHub API. Set it to the URL of a GitHub Enterprise
+	// Server instance, e.g. https://github.example.com/, to manage resources
+	// there instead of on github.com. The /api/v3/ path is appended if it is
+	// missing.
+	// +optional
+	BaseURL *string `json:"baseURL,omitempty"`
+
+	// UploadURL of the GitHub Enterprise Server instance, used to upload
+	// release assets. Defaults to BaseURL. The /api/uploads/ path is appended
+	// if it is missing.
+	// +optional
+	UploadURL *string `json:"uploadURL,omitempty"`
+
+	// ProxyURL of the HTTP(S) proxy used to reach the GitHub API, e.g.
+	// http://proxy.example.com:3128. Defaults to the proxy configured by the
+	// HTTPS_PROXY and HTTP_PROXY environment variables of the provider.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+
+	// CABundleSecretRef references a Secret key holding PEM encoded CA
+	// certificates that are trusted, in addition to the system roots, when
+	// verifying the certificate of the GitHub API server.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// Timeout of each request to the GitHub API, such as 30s or 1m. Requests
+	// do not time out by default.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -35,6 +65,24 @@ type ProviderCredentials struct {
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// App authenticates the provider as a GitHub App installation instead of
+	// with a personal access token. When set, the credentials source must
+	// supply the PEM encoded private key of the App.
+	// +optional
+	App *AppCredentials `json:"app,omitempty"`
+}
+
+// AppCredentials identify the GitHub App installation the provider
+// authenticates as. Installation access tokens are minted from them and
+// refreshed before they expire.
+type AppCredentials struct {
+	// ID of the GitHub App.
+	ID int64 `json:"id"`
+
+	// InstallationID is the ID of the App installation in the organization
+	// or user account that owns the managed resources.
+	InstallationID int64 `json:"installationId"`
 }
 
 // A ProviderConfigStatus represents the status of a ProviderConfig.
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+const (
+	errDecodePrivateKey        = "cannot decode PEM encoded GitHub App private key"
+	errParsePrivateKey         = "cannot parse GitHub App private key"
+	errNotRSAPrivateKey        = "GitHub App private key is not an RSA key"
+	errSignJWT                 = "cannot sign GitHub App JWT"
+	errCreateInstallationToken = "cannot create GitHub App installation token"
+
+	// GitHub rejects App JWTs that expire more than ten minutes in the future.
+	appJWTLifetime = 9 * time.Minute
+
+	// The App JWT is backdated to allow for clock drift between us and GitHub.
+	appJWTClockDrift = time.Minute
+
+	// Installation tokens are refreshed this long before GitHub expires them,
+	// so that in-flight requests never carry an expired token.
+	installationTokenExpiryDelta = 5 * time.Minute
+)
+
+// NewAppTokenSource returns an oauth2.TokenSource that mints installation
+// access tokens for the GitHub App installation of the supplied Config, whose
+// Credentials must be the private key of the App. Tokens are cached and
+// refreshed shortly before they expire. The supplied http.Client is used to
+// request the tokens from GitHub.
+func NewAppTokenSource(cfg Config, hc *http.Client) (oauth2.TokenSource, error) {
+	key, err := parsePrivateKey(cfg.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	at := &appTransport{id: cfg.App.ID, key: key, base: hc.Transport}
+	gh, err := newGitHubClient(cfg, &http.Client{Transport: at, Timeout: hc.Timeout})
+	if err != nil {
+		return nil, err
+	}
+	ts := &appTokenSource{installationID: cfg.App.InstallationID, client: gh}
+	return oauth2.ReuseTokenSource(nil, ts), nil
+}
+
+// An appTokenSource mints a new installation access token every time Token is
+// called.
+type appTokenSource struct {
+	installationID int64
+	client         *github.Client
+}
+
+// Token returns a new installation access token.
+func (s *appTokenSource) Token() (*oauth2.Token, error) {
+	t, _, err := s.client.Apps.CreateInstallationToken(context.Background(), s.installationID, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateInstallationToken)
+	}
+	return &oauth2.Token{
+		AccessToken: t.GetToken(),
+		Expiry:      t.GetExpiresAt().Add(-installationTokenExpiryDelta),
+	}, nil
+}
+
+// An appTransport authenticates requests as the GitHub App itself, using a
+// short lived JWT signed with the private key of the App.
+type appTransport struct {
+	id   int64
+	key  *rsa.PrivateKey
+	base http.RoundTripper
+}
+
+// RoundTrip signs a new JWT and adds it to a copy of the supplied request.
+func (t *appTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	jwt, err := signAppJWT(t.id, t.key, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+jwt)
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(r)
+}
+
+// signAppJWT returns an RS256 signed JWT that authenticates as the GitHub App
+// with the supplied ID.
+func signAppJWT(id int64, key *rsa.PrivateKey, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", errors.Wrap(err, errSignJWT)
+	}
+	claims, err := json.Marshal(map[string]int64{
+		"iat": now.Add(-appJWTClockDrift).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": id,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errSignJWT)
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", errors.Wrap(err, errSignJWT)
+	}
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
+
+// parsePrivateKey parses a PEM encoded RSA private key, either in PKCS #1 form
+// as downloaded from GitHub or in PKCS #8 form.
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New(errDecodePrivateKey)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, errParsePrivateKey)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New(errNotRSAPrivateKey)
+	}
+	return rsaKey, nil
+}
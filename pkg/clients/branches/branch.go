@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branches
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// Service defines the Repository branch operations
+type Service interface {
+	GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error)
+	GetRef(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error)
+	CreateRef(ctx context.Context, owner, repo string, ref *github.Reference) (*github.Reference, *github.Response, error)
+	DeleteRef(ctx context.Context, owner, repo, ref string) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func (s *service) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	return s.client.Repositories.Get(ctx, owner, repo)
+}
+
+func (s *service) GetRef(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error) {
+	return s.client.Git.GetRef(ctx, owner, repo, ref)
+}
+
+func (s *service) CreateRef(ctx context.Context, owner, repo string, ref *github.Reference) (*github.Reference, *github.Response, error) {
+	return s.client.Git.CreateRef(ctx, owner, repo, ref)
+}
+
+func (s *service) DeleteRef(ctx context.Context, owner, repo, ref string) (*github.Response, error) {
+	return s.client.Git.DeleteRef(ctx, owner, repo, ref)
+}
+
+// Ref returns the reference of the branch with the supplied name, the way the
+// git refs API expects it.
+func Ref(branch string) string {
+	return "heads/" + branch
+}
+
+// SourceSHA returns the SHA of the commit a branch is created from, which is
+// either given or the head of its source branch. The source branch defaults
+// to the default branch of the Repository.
+func SourceSHA(ctx context.Context, s Service, p v1alpha1.BranchParameters) (string, error) {
+	if p.SourceSHA != nil {
+		return *p.SourceSHA, nil
+	}
+
+	source := ghclient.StringValue(p.SourceBranch)
+	if source == "" {
+		r, _, err := s.GetRepository(ctx, p.Owner, p.Repository)
+		if err != nil {
+			return "", err
+		}
+		source = r.GetDefaultBranch()
+	}
+
+	ref, _, err := s.GetRef(ctx, p.Owner, p.Repository, Ref(source))
+	if err != nil {
+		return "", err
+	}
+	return ref.GetObject().GetSHA(), nil
+}
+
+// GenerateReference produces github.Reference object that creates the branch
+// given in BranchParameters at the supplied commit.
+func GenerateReference(p v1alpha1.BranchParameters, sha string) *github.Reference {
+	return &github.Reference{
+		Ref:    ghclient.StringPtr("refs/" + Ref(p.Name)),
+		Object: &github.GitObject{SHA: ghclient.StringPtr(sha)},
+	}
+}
+
+// GenerateObservation produces BranchObservation object from
+// github.Reference object.
+func GenerateObservation(r *github.Reference) v1alpha1.BranchObservation {
+	return v1alpha1.BranchObservation{
+		Ref: r.GetRef(),
+		SHA: r.GetObject().GetSHA(),
+		URL: r.GetURL(),
+	}
+}
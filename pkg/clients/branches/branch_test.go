@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branches
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func TestGenerateReference(t *testing.T) {
+	p := v1alpha1.BranchParameters{Name: "release/v1"}
+	want := &github.Reference{
+		Ref:    github.String("refs/heads/release/v1"),
+		Object: &github.GitObject{SHA: github.String("aa218f56b14c9653891f9e74264a383fa43fefbd")},
+	}
+
+	got := GenerateReference(p, "aa218f56b14c9653891f9e74264a383fa43fefbd")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateReference(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	r := &github.Reference{
+		Ref:    github.String("refs/heads/develop"),
+		URL:    github.String("https://api.github.com/repos/crossplane/sample/git/refs/heads/develop"),
+		Object: &github.GitObject{SHA: github.String("aa218f56b14c9653891f9e74264a383fa43fefbd")},
+	}
+	want := v1alpha1.BranchObservation{
+		Ref: "refs/heads/develop",
+		SHA: "aa218f56b14c9653891f9e74264a383fa43fefbd",
+		URL: "https://api.github.com/repos/crossplane/sample/git/refs/heads/develop",
+	}
+
+	got := GenerateObservation(r)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branchprotections
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// Service defines the Branch protection operations
+type Service interface {
+	GetBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error)
+	UpdateBranchProtection(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error)
+	RemoveBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+// service protects branches given by name through the REST API, and branches
+// given by pattern through the branch protection rules of the GraphQL API.
+type service struct {
+	client *github.Client
+}
+
+func (s *service) GetBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+	if !IsPattern(branch) {
+		return s.client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	}
+	r, res, err := s.findRule(ctx, owner, repo, branch)
+	if err != nil {
+		return nil, res, err
+	}
+	return r.protection(), res, nil
+}
+
+func (s *service) UpdateBranchProtection(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error) {
+	if !IsPattern(branch) {
+		return s.client.Repositories.UpdateBranchProtection(ctx, owner, repo, branch, preq)
+	}
+	r, res, err := s.putRule(ctx, owner, repo, branch, preq)
+	if err != nil {
+		return nil, res, err
+	}
+	return r.protection(), res, nil
+}
+
+func (s *service) RemoveBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Response, error) {
+	if !IsPattern(branch) {
+		return s.client.Repositories.RemoveBranchProtection(ctx, owner, repo, branch)
+	}
+	return s.deleteRule(ctx, owner, repo, branch)
+}
+
+// IsPattern checks whether the supplied branch is a pattern matching several
+// branches rather than the name of a branch.
+func IsPattern(branch string) bool {
+	return strings.ContainsAny(branch, "*?[")
+}
+
+// GenerateProtectionRequest produces github.ProtectionRequest from
+// BranchProtectionParameters. The request replaces the whole protection of the
+// branch, so settings that are not given are disabled.
+func GenerateProtectionRequest(p v1alpha1.BranchProtectionParameters) *github.ProtectionRequest {
+	r := &github.ProtectionRequest{
+		EnforceAdmins:        ghclient.BoolValue(p.EnforceAdmins),
+		RequireLinearHistory: p.RequireLinearHistory,
+		AllowForcePushes:     p.AllowForcePushes,
+		AllowDeletions:       p.AllowDeletions,
+	}
+	if c := p.RequiredStatusChecks; c != nil {
+		r.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Strict:   c.Strict,
+			Contexts: ghclient.StringSliceValue(c.Contexts),
+		}
+	}
+	if rv := p.RequiredPullRequestReviews; rv != nil {
+		r.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          ghclient.BoolValue(rv.DismissStaleReviews),
+			RequireCodeOwnerReviews:      ghclient.BoolValue(rv.RequireCodeOwnerReviews),
+			RequiredApprovingReviewCount: ghclient.IntValue(rv.RequiredApprovingReviewCount),
+		}
+	}
+	if rs := p.Restrictions; rs != nil {
+		r.Restrictions = &github.BranchRestrictionsRequest{
+			Users: ghclient.StringSliceValue(rs.Users),
+			Teams: ghclient.StringSliceValue(rs.Teams),
+			Apps:  rs.Apps,
+		}
+	}
+	return r
+}
+
+// GenerateParameters produces BranchProtectionParameters from the observed
+// github.Protection. Only the protection settings are filled.
+func GenerateParameters(pr *github.Protection) v1alpha1.BranchProtectionParameters {
+	p := v1alpha1.BranchProtectionParameters{}
+	if c := pr.RequiredStatusChecks; c != nil {
+		p.RequiredStatusChecks = &v1alpha1.RequiredStatusChecks{
+			Strict:   c.Strict,
+			Contexts: c.Contexts,
+		}
+	}
+	if rv := pr.RequiredPullRequestReviews; rv != nil {
+		p.RequiredPullRequestReviews = &v1alpha1.RequiredPullRequestReviews{
+			DismissStaleReviews:          github.Bool(rv.DismissStaleReviews),
+			RequireCodeOwnerReviews:      github.Bool(rv.RequireCodeOwnerReviews),
+			RequiredApprovingReviewCount: github.Int(rv.RequiredApprovingReviewCount),
+		}
+	}
+	if e := pr.EnforceAdmins; e != nil {
+		p.EnforceAdmins = github.Bool(e.Enabled)
+	}
+	if rs := pr.Restrictions; rs != nil {
+		p.Restrictions = &v1alpha1.PushRestrictions{}
+		for _, u := range rs.Users {
+			p.Restrictions.Users = append(p.Restrictions.Users, u.GetLogin())
+		}
+		for _, t := range rs.Teams {
+			p.Restrictions.Teams = append(p.Restrictions.Teams, t.GetSlug())
+		}
+		for _, a := range rs.Apps {
+			p.Restrictions.Apps = append(p.Restrictions.Apps, a.GetSlug())
+		}
+	}
+	if l := pr.RequireLinearHistory; l != nil {
+		p.RequireLinearHistory = github.Bool(l.Enabled)
+	}
+	if f := pr.AllowForcePushes; f != nil {
+		p.AllowForcePushes = github.Bool(f.Enabled)
+	}
+	if d := pr.AllowDeletions; d != nil {
+		p.AllowDeletions = github.Bool(d.Enabled)
+	}
+	return p
+}
+
+// IsUpToDate checks whether the observed github.Protection is configured with
+// the given BranchProtectionParameters. Settings that are not given are not
+// compared.
+func IsUpToDate(p v1alpha1.BranchProtectionParameters, observed *github.Protection) bool {
+	current := GenerateParameters(observed)
+	desired := OverrideParameters(p, GenerateParameters(observed))
+
+	return cmp.Equal(desired, current,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+	)
+}
+
+// OverrideParameters override the protection settings in
+// BranchProtectionParameters that are defined in the desired
+// BranchProtectionParameters.
+func OverrideParameters(p, bp v1alpha1.BranchProtectionParameters) v1alpha1.BranchProtectionParameters { // nolint:gocyclo
+	if p.RequiredStatusChecks != nil {
+		bp.RequiredStatusChecks = p.RequiredStatusChecks
+	}
+	if rv := p.RequiredPullRequestReviews; rv != nil {
+		if bp.RequiredPullRequestReviews == nil {
+			bp.RequiredPullRequestReviews = &v1alpha1.RequiredPullRequestReviews{}
+		}
+		if rv.DismissStaleReviews != nil {
+			bp.RequiredPullRequestReviews.DismissStaleReviews = rv.DismissStaleReviews
+		}
+		if rv.RequireCodeOwnerReviews != nil {
+			bp.RequiredPullRequestReviews.RequireCodeOwnerReviews = rv.RequireCodeOwnerReviews
+		}
+		if rv.RequiredApprovingReviewCount != nil {
+			bp.RequiredPullRequestReviews.RequiredApprovingReviewCount = rv.RequiredApprovingReviewCount
+		}
+	}
+	if p.EnforceAdmins != nil {
+		bp.EnforceAdmins = p.EnforceAdmins
+	}
+	if p.Restrictions != nil {
+		bp.Restrictions = p.Restrictions
+	}
+	if p.RequireLinearHistory != nil {
+		bp.RequireLinearHistory = p.RequireLinearHistory
+	}
+	if p.AllowForcePushes != nil {
+		bp.AllowForcePushes = p.AllowForcePushes
+	}
+	if p.AllowDeletions != nil {
+		bp.AllowDeletions = p.AllowDeletions
+	}
+	return bp
+}
+
+// LateInitialize fills the empty fields of BranchProtectionParameters if the
+// corresponding fields are given in github.Protection.
+func LateInitialize(p *v1alpha1.BranchProtectionParameters, pr *github.Protection) { // nolint:gocyclo
+	o := GenerateParameters(pr)
+	if p.RequiredStatusChecks == nil {
+		p.RequiredStatusChecks = o.RequiredStatusChecks
+	}
+	if p.RequiredPullRequestReviews == nil {
+		p.RequiredPullRequestReviews = o.RequiredPullRequestReviews
+	} else if o.RequiredPullRequestReviews != nil {
+		rv := p.RequiredPullRequestReviews
+		if rv.DismissStaleReviews == nil {
+			rv.DismissStaleReviews = o.RequiredPullRequestReviews.DismissStaleReviews
+		}
+		if rv.RequireCodeOwnerReviews == nil {
+			rv.RequireCodeOwnerReviews = o.RequiredPullRequestReviews.RequireCodeOwnerReviews
+		}
+		if rv.RequiredApprovingReviewCount == nil {
+			rv.RequiredApprovingReviewCount = o.RequiredPullRequestReviews.RequiredApprovingReviewCount
+		}
+	}
+	if p.EnforceAdmins == nil {
+		p.EnforceAdmins = o.EnforceAdmins
+	}
+	if p.Restrictions == nil {
+		p.Restrictions = o.Restrictions
+	}
+	if p.RequireLinearHistory == nil {
+		p.RequireLinearHistory = o.RequireLinearHistory
+	}
+	if p.AllowForcePushes == nil {
+		p.AllowForcePushes = o.AllowForcePushes
+	}
+	if p.AllowDeletions == nil {
+		p.AllowDeletions = o.AllowDeletions
+	}
+}
+
+// GenerateObservation produces BranchProtectionObservation object from
+// github.Protection object.
+func GenerateObservation(pr *github.Protection) v1alpha1.BranchProtectionObservation {
+	o := v1alpha1.BranchProtectionObservation{}
+	if c := pr.RequiredStatusChecks; c != nil {
+		o.RequiredStatusChecks = c.Contexts
+	}
+	if rv := pr.RequiredPullRequestReviews; rv != nil {
+		o.RequiredApprovingReviewCount = rv.RequiredApprovingReviewCount
+	}
+	if e := pr.EnforceAdmins; e != nil {
+		o.EnforceAdmins = e.Enabled
+	}
+	return o
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branchprotections
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+func protection() *github.Protection {
+	return &github.Protection{
+		RequiredStatusChecks: &github.RequiredStatusChecks{Strict: true, Contexts: []string{"ci/build", "ci/lint"}},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+			DismissStaleReviews:          true,
+			RequiredApprovingReviewCount: 2,
+		},
+		EnforceAdmins: &github.AdminEnforcement{Enabled: true},
+		Restrictions: &github.BranchRestrictions{
+			Users: []*github.User{{Login: github.String("octocat")}, {Login: github.String("hubot")}},
+			Teams: []*github.Team{{Slug: github.String("platform")}},
+		},
+		RequireLinearHistory: &github.RequireLinearHistory{Enabled: true},
+		AllowForcePushes:     &github.AllowForcePushes{Enabled: false},
+		AllowDeletions:       &github.AllowDeletions{Enabled: false},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.BranchProtectionParameters
+		want   bool
+	}{
+		"NothingGiven": {
+			reason: "Settings that are not given must not be compared",
+			p:      v1alpha1.BranchProtectionParameters{},
+			want:   true,
+		},
+		"UpToDate": {
+			reason: "Must return true if the given settings match regardless of the order of lists",
+			p: v1alpha1.BranchProtectionParameters{
+				RequiredStatusChecks: &v1alpha1.RequiredStatusChecks{Strict: true, Contexts: []string{"ci/lint", "ci/build"}},
+				RequiredPullRequestReviews: &v1alpha1.RequiredPullRequestReviews{
+					RequiredApprovingReviewCount: github.Int(2),
+				},
+				EnforceAdmins: github.Bool(true),
+				Restrictions: &v1alpha1.PushRestrictions{
+					Users: []string{"hubot", "octocat"},
+					Teams: []string{"platform"},
+				},
+				AllowDeletions: github.Bool(false),
+			},
+			want: true,
+		},
+		"ReviewCountChanged": {
+			reason: "Must return false if a nested setting changed",
+			p: v1alpha1.BranchProtectionParameters{
+				RequiredPullRequestReviews: &v1alpha1.RequiredPullRequestReviews{
+					RequiredApprovingReviewCount: github.Int(1),
+				},
+			},
+			want: false,
+		},
+		"CodeOwnerReviewsChanged": {
+			reason: "Must return false if code owner reviews are required but not enforced",
+			p: v1alpha1.BranchProtectionParameters{
+				RequiredPullRequestReviews: &v1alpha1.RequiredPullRequestReviews{
+					RequireCodeOwnerReviews: github.Bool(true),
+				},
+			},
+			want: false,
+		},
+		"RestrictionsChanged": {
+			reason: "Must return false if the users that can push changed",
+			p: v1alpha1.BranchProtectionParameters{
+				Restrictions: &v1alpha1.PushRestrictions{
+					Users: []string{"octocat"},
+					Teams: []string{"platform"},
+				},
+			},
+			want: false,
+		},
+		"ForcePushesChanged": {
+			reason: "Must return false if force pushes are allowed but not permitted",
+			p: v1alpha1.BranchProtectionParameters{
+				AllowForcePushes: github.Bool(true),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, protection())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProtectionRequest(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.BranchProtectionParameters
+		want   *github.ProtectionRequest
+	}{
+		"Empty": {
+			reason: "Settings that are not given must be disabled",
+			p:      v1alpha1.BranchProtectionParameters{},
+			want:   &github.ProtectionRequest{},
+		},
+		"Full": {
+			reason: "Lists GitHub requires must not be nil",
+			p: v1alpha1.BranchProtectionParameters{
+				RequiredStatusChecks: &v1alpha1.RequiredStatusChecks{Strict: true},
+				RequiredPullRequestReviews: &v1alpha1.RequiredPullRequestReviews{
+					RequireCodeOwnerReviews:      github.Bool(true),
+					RequiredApprovingReviewCount: github.Int(1),
+				},
+				EnforceAdmins:        github.Bool(true),
+				Restrictions:         &v1alpha1.PushRestrictions{Teams: []string{"platform"}},
+				RequireLinearHistory: github.Bool(true),
+			},
+			want: &github.ProtectionRequest{
+				RequiredStatusChecks: &github.RequiredStatusChecks{Strict: true, Contexts: []string{}},
+				RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{
+					RequireCodeOwnerReviews:      true,
+					RequiredApprovingReviewCount: 1,
+				},
+				EnforceAdmins:        true,
+				Restrictions:         &github.BranchRestrictionsRequest{Users: []string{}, Teams: []string{"platform"}},
+				RequireLinearHistory: github.Bool(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateProtectionRequest(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateProtectionRequest(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      *v1alpha1.BranchProtectionParameters
+		want   *v1alpha1.BranchProtectionParameters
+	}{
+		"NestedFields": {
+			reason: "Must late initialize the empty nested fields without overriding the given ones",
+			p: &v1alpha1.BranchProtectionParameters{
+				RequiredPullRequestReviews: &v1alpha1.RequiredPullRequestReviews{
+					RequiredApprovingReviewCount: github.Int(3),
+				},
+				AllowDeletions: github.Bool(true),
+			},
+			want: &v1alpha1.BranchProtectionParameters{
+				RequiredStatusChecks: &v1alpha1.RequiredStatusChecks{Strict: true, Contexts: []string{"ci/build", "ci/lint"}},
+				RequiredPullRequestReviews: &v1alpha1.RequiredPullRequestReviews{
+					DismissStaleReviews:          github.Bool(true),
+					RequireCodeOwnerReviews:      github.Bool(false),
+					RequiredApprovingReviewCount: github.Int(3),
+				},
+				EnforceAdmins: github.Bool(true),
+				Restrictions: &v1alpha1.PushRestrictions{
+					Users: []string{"octocat", "hubot"},
+					Teams: []string{"platform"},
+				},
+				RequireLinearHistory: github.Bool(true),
+				AllowForcePushes:     github.Bool(false),
+				AllowDeletions:       github.Bool(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, protection())
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("\n%s\nLateInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A branch protection rule as it is returned by the GraphQL API.
+const fakeRule = `{
+  "id": "BPR_1",
+  "pattern": "release/*",
+  "requiresStatusChecks": true,
+  "requiresStrictStatusChecks": true,
+  "requiredStatusCheckContexts": ["ci/build", "ci/lint"],
+  "requiresApprovingReviews": true,
+  "requiredApprovingReviewCount": 2,
+  "dismissesStaleReviews": true,
+  "requiresCodeOwnerReviews": false,
+  "isAdminEnforced": true,
+  "restrictsPushes": true,
+  "pushAllowances": {"nodes": [
+    {"actor": {"__typename": "User", "login": "octocat"}},
+    {"actor": {"__typename": "User", "login": "hubot"}},
+    {"actor": {"__typename": "Team", "slug": "platform"}}
+  ]},
+  "requiresLinearHistory": true,
+  "allowsForcePushes": false,
+  "allowsDeletions": false
+}`
+
+// ruleServer serves the GraphQL API of a Repository with the supplied rules,
+// and records the input of the last mutation.
+func ruleServer(rules string, input *map[string]interface{}) (*service, func()) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/graphql":
+		case "/users/octocat", "/users/hubot":
+			_, _ = w.Write([]byte(`{"node_id": "U_` + strings.TrimPrefix(r.URL.Path, "/users/") + `"}`))
+			return
+		case "/orgs/crossplane/teams/platform":
+			_, _ = w.Write([]byte(`{"node_id": "T_platform"}`))
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		req := &struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(req)
+		switch {
+		case strings.HasPrefix(req.Query, "query"):
+			_, _ = w.Write([]byte(`{"data": {"repository": {"id": "R_1", "branchProtectionRules": {"nodes": ` + rules + `, "pageInfo": {"hasNextPage": false}}}}}`))
+		case strings.Contains(req.Query, "createBranchProtectionRule"):
+			*input = req.Variables["input"].(map[string]interface{})
+			_, _ = w.Write([]byte(`{"data": {"createBranchProtectionRule": {"branchProtectionRule": ` + fakeRule + `}}}`))
+		case strings.Contains(req.Query, "updateBranchProtectionRule"):
+			*input = req.Variables["input"].(map[string]interface{})
+			_, _ = w.Write([]byte(`{"data": {"updateBranchProtectionRule": {"branchProtectionRule": ` + fakeRule + `}}}`))
+		case strings.Contains(req.Query, "deleteBranchProtectionRule"):
+			*input = req.Variables["input"].(map[string]interface{})
+			_, _ = w.Write([]byte(`{"data": {"deleteBranchProtectionRule": {"clientMutationId": null}}}`))
+		}
+	}))
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	return &service{client: gh}, srv.Close
+}
+
+func TestServiceGetPatternBranchProtection(t *testing.T) {
+	s, done := ruleServer("["+fakeRule+"]", nil)
+	defer done()
+
+	got, _, err := s.GetBranchProtection(context.Background(), "crossplane", "sample", "release/*")
+	if err != nil {
+		t.Fatalf("GetBranchProtection(...): %s", err)
+	}
+	if diff := cmp.Diff(GenerateParameters(protection()), GenerateParameters(got)); diff != "" {
+		t.Errorf("GetBranchProtection(...): -want, +got:\n%s", diff)
+	}
+
+	_, _, err = s.GetBranchProtection(context.Background(), "crossplane", "sample", "hotfix/*")
+	if !ghclient.IsNotFound(err) {
+		t.Errorf("GetBranchProtection(...): want a not found error for a pattern without rule, got %v", err)
+	}
+}
+
+func TestServiceUpdatePatternBranchProtection(t *testing.T) {
+	p := v1alpha1.BranchProtectionParameters{
+		Branch:       "release/*",
+		Restrictions: &v1alpha1.PushRestrictions{Users: []string{"octocat"}, Teams: []string{"platform"}},
+	}
+
+	cases := map[string]struct {
+		reason string
+		rules  string
+		want   map[string]string
+	}{
+		"Create": {
+			reason: "A rule must be created in the Repository if none has the pattern",
+			rules:  "[]",
+			want:   map[string]string{"repositoryId": "R_1"},
+		},
+		"Update": {
+			reason: "The rule with the pattern must be updated if it exists",
+			rules:  "[" + fakeRule + "]",
+			want:   map[string]string{"branchProtectionRuleId": "BPR_1"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input map[string]interface{}
+			s, done := ruleServer(tc.rules, &input)
+			defer done()
+
+			if _, _, err := s.UpdateBranchProtection(context.Background(), "crossplane", "sample", p.Branch, GenerateProtectionRequest(p)); err != nil {
+				t.Fatalf("\n%s\nUpdateBranchProtection(...): %s", tc.reason, err)
+			}
+			for k, v := range tc.want {
+				if diff := cmp.Diff(v, input[k]); diff != "" {
+					t.Errorf("\n%s\nUpdateBranchProtection(...): -want %s, +got %s:\n%s", tc.reason, k, k, diff)
+				}
+			}
+			want := []interface{}{"U_octocat", "T_platform"}
+			if diff := cmp.Diff(want, input["pushActorIds"]); diff != "" {
+				t.Errorf("\n%s\nUpdateBranchProtection(...): -want pushActorIds, +got pushActorIds:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServiceRemovePatternBranchProtection(t *testing.T) {
+	var input map[string]interface{}
+	s, done := ruleServer("["+fakeRule+"]", &input)
+	defer done()
+
+	if _, err := s.RemoveBranchProtection(context.Background(), "crossplane", "sample", "release/*"); err != nil {
+		t.Fatalf("RemoveBranchProtection(...): %s", err)
+	}
+	if diff := cmp.Diff("BPR_1", input["branchProtectionRuleId"]); diff != "" {
+		t.Errorf("RemoveBranchProtection(...): -want rule ID, +got rule ID:\n%s", diff)
+	}
+}
+
+func TestGraphQLPath(t *testing.T) {
+	ghes, _ := github.NewEnterpriseClient("https://github.example.com/", "https://github.example.com/", nil)
+	if diff := cmp.Diff("../graphql", graphQLPath(ghes)); diff != "" {
+		t.Errorf("graphQLPath(...): GitHub Enterprise Server: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("graphql", graphQLPath(github.NewClient(nil))); diff != "" {
+		t.Errorf("graphQLPath(...): github.com: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branchprotections
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+const errGraphQL = "GraphQL request failed"
+
+const ruleFields = `id
+pattern
+requiresStatusChecks
+requiresStrictStatusChecks
+requiredStatusCheckContexts
+requiresApprovingReviews
+requiredApprovingReviewCount
+dismissesStaleReviews
+requiresCodeOwnerReviews
+isAdminEnforced
+restrictsPushes
+pushAllowances(first: 100) {
+  nodes {
+    actor {
+      __typename
+      ... on User { login }
+      ... on Team { slug }
+      ... on App { slug }
+    }
+  }
+}
+requiresLinearHistory
+allowsForcePushes
+allowsDeletions`
+
+const queryRules = `query($owner: String!, $name: String!, $cursor: String) {
+  repository(owner: $owner, name: $name) {
+    id
+    branchProtectionRules(first: 100, after: $cursor) {
+      nodes {
+` + ruleFields + `
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+const mutationCreateRule = `mutation($input: CreateBranchProtectionRuleInput!) {
+  createBranchProtectionRule(input: $input) {
+    branchProtectionRule {
+` + ruleFields + `
+    }
+  }
+}`
+
+const mutationUpdateRule = `mutation($input: UpdateBranchProtectionRuleInput!) {
+  updateBranchProtectionRule(input: $input) {
+    branchProtectionRule {
+` + ruleFields + `
+    }
+  }
+}`
+
+const mutationDeleteRule = `mutation($input: DeleteBranchProtectionRuleInput!) {
+  deleteBranchProtectionRule(input: $input) { clientMutationId }
+}`
+
+// A rule is a branch protection rule of the GraphQL API, which protects the
+// branches matching its pattern.
+type rule struct {
+	ID                           string   `json:"id"`
+	Pattern                      string   `json:"pattern"`
+	RequiresStatusChecks         bool     `json:"requiresStatusChecks"`
+	RequiresStrictStatusChecks   bool     `json:"requiresStrictStatusChecks"`
+	RequiredStatusCheckContexts  []string `json:"requiredStatusCheckContexts"`
+	RequiresApprovingReviews     bool     `json:"requiresApprovingReviews"`
+	RequiredApprovingReviewCount int      `json:"requiredApprovingReviewCount"`
+	DismissesStaleReviews        bool     `json:"dismissesStaleReviews"`
+	RequiresCodeOwnerReviews     bool     `json:"requiresCodeOwnerReviews"`
+	IsAdminEnforced              bool     `json:"isAdminEnforced"`
+	RestrictsPushes              bool     `json:"restrictsPushes"`
+	PushAllowances               struct {
+		Nodes []struct {
+			Actor struct {
+				Typename string `json:"__typename"`
+				Login    string `json:"login"`
+				Slug     string `json:"slug"`
+			} `json:"actor"`
+		} `json:"nodes"`
+	} `json:"pushAllowances"`
+	RequiresLinearHistory bool `json:"requiresLinearHistory"`
+	AllowsForcePushes     bool `json:"allowsForcePushes"`
+	AllowsDeletions       bool `json:"allowsDeletions"`
+}
+
+// ruleInput is the input of the mutations that create and update rules.
+type ruleInput struct {
+	RepositoryID                 string   `json:"repositoryId,omitempty"`
+	BranchProtectionRuleID       string   `json:"branchProtectionRuleId,omitempty"`
+	Pattern                      string   `json:"pattern"`
+	RequiresStatusChecks         bool     `json:"requiresStatusChecks"`
+	RequiresStrictStatusChecks   bool     `json:"requiresStrictStatusChecks"`
+	RequiredStatusCheckContexts  []string `json:"requiredStatusCheckContexts"`
+	RequiresApprovingReviews     bool     `json:"requiresApprovingReviews"`
+	RequiredApprovingReviewCount int      `json:"requiredApprovingReviewCount"`
+	DismissesStaleReviews        bool     `json:"dismissesStaleReviews"`
+	RequiresCodeOwnerReviews     bool     `json:"requiresCodeOwnerReviews"`
+	IsAdminEnforced              bool     `json:"isAdminEnforced"`
+	RestrictsPushes              bool     `json:"restrictsPushes"`
+	PushActorIDs                 []string `json:"pushActorIds"`
+	RequiresLinearHistory        bool     `json:"requiresLinearHistory"`
+	AllowsForcePushes            bool     `json:"allowsForcePushes"`
+	AllowsDeletions              bool     `json:"allowsDeletions"`
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+// protection converts the rule into the github.Protection the REST API
+// returns for a protected branch.
+func (r *rule) protection() *github.Protection {
+	p := &github.Protection{
+		EnforceAdmins:        &github.AdminEnforcement{Enabled: r.IsAdminEnforced},
+		RequireLinearHistory: &github.RequireLinearHistory{Enabled: r.RequiresLinearHistory},
+		AllowForcePushes:     &github.AllowForcePushes{Enabled: r.AllowsForcePushes},
+		AllowDeletions:       &github.AllowDeletions{Enabled: r.AllowsDeletions},
+	}
+	if r.RequiresStatusChecks {
+		p.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Strict:   r.RequiresStrictStatusChecks,
+			Contexts: r.RequiredStatusCheckContexts,
+		}
+	}
+	if r.RequiresApprovingReviews {
+		p.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcement{
+			DismissStaleReviews:          r.DismissesStaleReviews,
+			RequireCodeOwnerReviews:      r.RequiresCodeOwnerReviews,
+			RequiredApprovingReviewCount: r.RequiredApprovingReviewCount,
+		}
+	}
+	if r.RestrictsPushes {
+		p.Restrictions = &github.BranchRestrictions{}
+		for _, n := range r.PushAllowances.Nodes {
+			switch n.Actor.Typename {
+			case "User":
+				p.Restrictions.Users = append(p.Restrictions.Users, &github.User{Login: ghclient.StringPtr(n.Actor.Login)})
+			case "Team":
+				p.Restrictions.Teams = append(p.Restrictions.Teams, &github.Team{Slug: ghclient.StringPtr(n.Actor.Slug)})
+			case "App":
+				p.Restrictions.Apps = append(p.Restrictions.Apps, &github.App{Slug: ghclient.StringPtr(n.Actor.Slug)})
+			}
+		}
+	}
+	return p
+}
+
+// graphQLPath returns the path of the GraphQL endpoint relative to the base
+// URL of the REST API. GitHub Enterprise Server serves it at /api/graphql
+// rather than below /api/v3.
+func graphQLPath(c *github.Client) string {
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
+
+// graphQL runs the supplied query and decodes its data into v.
+func (s *service) graphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*github.Response, error) {
+	req, err := s.client.NewRequest("POST", graphQLPath(s.client), &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+	body := &struct {
+		Data   interface{}    `json:"data"`
+		Errors []graphQLError `json:"errors"`
+	}{Data: v}
+	res, err := s.client.Do(ctx, req, body)
+	if err != nil {
+		return res, err
+	}
+	if len(body.Errors) > 0 {
+		msgs := make([]string, len(body.Errors))
+		for i, e := range body.Errors {
+			msgs[i] = e.Message
+		}
+		return res, errors.Wrap(errors.New(strings.Join(msgs, "; ")), errGraphQL)
+	}
+	return res, nil
+}
+
+// listRules returns the ID of the Repository and its branch protection
+// rules.
+func (s *service) listRules(ctx context.Context, owner, repo string) (string, []rule, *github.Response, error) {
+	var rules []rule
+	vars := map[string]interface{}{"owner": owner, "name": repo}
+	for {
+		data := &struct {
+			Repository struct {
+				ID                    string `json:"id"`
+				BranchProtectionRules struct {
+					Nodes    []rule `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"branchProtectionRules"`
+			} `json:"repository"`
+		}{}
+		res, err := s.graphQL(ctx, queryRules, vars, data)
+		if err != nil {
+			return "", nil, res, err
+		}
+		r := data.Repository
+		rules = append(rules, r.BranchProtectionRules.Nodes...)
+		if !r.BranchProtectionRules.PageInfo.HasNextPage {
+			return r.ID, rules, res, nil
+		}
+		vars["cursor"] = r.BranchProtectionRules.PageInfo.EndCursor
+	}
+}
+
+// findRule returns the rule with the supplied pattern, or a 404 error if the
+// Repository has none.
+func (s *service) findRule(ctx context.Context, owner, repo, pattern string) (*rule, *github.Response, error) {
+	_, rules, res, err := s.listRules(ctx, owner, repo)
+	if err != nil {
+		return nil, res, err
+	}
+	for i := range rules {
+		if rules[i].Pattern == pattern {
+			return &rules[i], res, nil
+		}
+	}
+	return nil, res, &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusNotFound, Request: &http.Request{Method: "POST", URL: s.client.BaseURL}},
+		Message:  "no branch protection rule with pattern " + pattern,
+	}
+}
+
+// putRule creates the rule with the supplied pattern, or updates it if it
+// exists.
+func (s *service) putRule(ctx context.Context, owner, repo, pattern string, preq *github.ProtectionRequest) (*rule, *github.Response, error) {
+	repoID, rules, res, err := s.listRules(ctx, owner, repo)
+	if err != nil {
+		return nil, res, err
+	}
+	in, err := s.generateRuleInput(ctx, owner, pattern, preq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mutation, field := mutationCreateRule, "createBranchProtectionRule"
+	in.RepositoryID = repoID
+	for _, r := range rules {
+		if r.Pattern == pattern {
+			mutation, field = mutationUpdateRule, "updateBranchProtectionRule"
+			in.RepositoryID, in.BranchProtectionRuleID = "", r.ID
+		}
+	}
+
+	data := map[string]*struct {
+		BranchProtectionRule *rule `json:"branchProtectionRule"`
+	}{}
+	res, err = s.graphQL(ctx, mutation, map[string]interface{}{"input": in}, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	if data[field] == nil || data[field].BranchProtectionRule == nil {
+		return nil, res, errors.New(errGraphQL)
+	}
+	return data[field].BranchProtectionRule, res, nil
+}
+
+// deleteRule deletes the rule with the supplied pattern.
+func (s *service) deleteRule(ctx context.Context, owner, repo, pattern string) (*github.Response, error) {
+	r, res, err := s.findRule(ctx, owner, repo, pattern)
+	if err != nil {
+		return res, err
+	}
+	in := map[string]interface{}{"branchProtectionRuleId": r.ID}
+	return s.graphQL(ctx, mutationDeleteRule, map[string]interface{}{"input": in}, &struct{}{})
+}
+
+// generateRuleInput produces the input of a rule mutation from the request
+// the REST API takes. The users, teams and apps that can push are given to
+// the GraphQL API by their node IDs.
+func (s *service) generateRuleInput(ctx context.Context, owner, pattern string, preq *github.ProtectionRequest) (*ruleInput, error) {
+	in := &ruleInput{
+		Pattern:                     pattern,
+		IsAdminEnforced:             preq.EnforceAdmins,
+		RequiredStatusCheckContexts: []string{},
+		PushActorIDs:                []string{},
+		RequiresLinearHistory:       ghclient.BoolValue(preq.RequireLinearHistory),
+		AllowsForcePushes:           ghclient.BoolValue(preq.AllowForcePushes),
+		AllowsDeletions:             ghclient.BoolValue(preq.AllowDeletions),
+	}
+	if c := preq.RequiredStatusChecks; c != nil {
+		in.RequiresStatusChecks = true
+		in.RequiresStrictStatusChecks = c.Strict
+		in.RequiredStatusCheckContexts = ghclient.StringSliceValue(c.Contexts)
+	}
+	if rv := preq.RequiredPullRequestReviews; rv != nil {
+		in.RequiresApprovingReviews = true
+		in.RequiredApprovingReviewCount = rv.RequiredApprovingReviewCount
+		in.DismissesStaleReviews = rv.DismissStaleReviews
+		in.RequiresCodeOwnerReviews = rv.RequireCodeOwnerReviews
+	}
+	if rs := preq.Restrictions; rs != nil {
+		in.RestrictsPushes = true
+		for _, login := range rs.Users {
+			u, _, err := s.client.Users.Get(ctx, login)
+			if err != nil {
+				return nil, err
+			}
+			in.PushActorIDs = append(in.PushActorIDs, u.GetNodeID())
+		}
+		for _, slug := range rs.Teams {
+			t, _, err := s.client.Teams.GetTeamBySlug(ctx, owner, slug)
+			if err != nil {
+				return nil, err
+			}
+			in.PushActorIDs = append(in.PushActorIDs, t.GetNodeID())
+		}
+		for _, slug := range rs.Apps {
+			a, _, err := s.client.Apps.Get(ctx, slug)
+			if err != nil {
+				return nil, err
+			}
+			in.PushActorIDs = append(in.PushActorIDs, a.GetNodeID())
+		}
+	}
+	return in, nil
+}
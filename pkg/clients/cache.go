@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+)
+
+const (
+	errHashConfig = "cannot hash GitHub client configuration"
+)
+
+// DefaultClientCache is the ClientCache shared by all controllers.
+var DefaultClientCache = NewClientCache()
+
+// GetClient returns the GitHub client for the supplied Config from the
+// DefaultClientCache.
+func GetClient(cfg Config) (*github.Client, error) {
+	return DefaultClientCache.Get(cfg)
+}
+
+// A ClientCache caches one GitHub client per ProviderConfig so that the
+// underlying connections to the GitHub API are reused across reconciles. The
+// cached client is replaced when the Config it was built from changes, e.g.
+// because the Secret holding its credentials was updated.
+type ClientCache struct {
+	mu      sync.Mutex
+	clients map[string]cachedClient
+
+	newClientFn func(Config) (*github.Client, *http.Client, error)
+}
+
+type cachedClient struct {
+	hash   string
+	client *github.Client
+	http   *http.Client
+}
+
+// NewClientCache returns an empty ClientCache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{
+		clients:     map[string]cachedClient{},
+		newClientFn: newClient,
+	}
+}
+
+// Get returns the cached client for the ProviderConfig of the supplied Config.
+// A new client is created if none is cached or the Config changed since the
+// cached client was created.
+func (c *ClientCache) Get(cfg Config) (*github.Client, error) {
+	h, err := hashConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.clients[cfg.ProviderConfigName]
+	if ok && cached.hash == h {
+		return cached.client, nil
+	}
+
+	gh, hc, err := c.newClientFn(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if ok && cached.http != nil {
+		cached.http.CloseIdleConnections()
+	}
+	c.clients[cfg.ProviderConfigName] = cachedClient{hash: h, client: gh, http: hc}
+	return gh, nil
+}
+
+// hashConfig returns a hash of everything a client is built from, including
+// its credentials.
+func hashConfig(cfg Config) (string, error) {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return "", errors.Wrap(err, errHashConfig)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
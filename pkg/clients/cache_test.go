@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+)
+
+func TestClientCacheGet(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		created int
+		same    bool
+		err     error
+	}
+	cases := map[string]struct {
+		reason string
+		first  Config
+		second Config
+		newErr error
+		want   want
+	}{
+		"Reuse": {
+			reason: "Must reuse the cached client if the Config did not change",
+			first:  Config{ProviderConfigName: "default", Credentials: []byte("a")},
+			second: Config{ProviderConfigName: "default", Credentials: []byte("a")},
+			want:   want{created: 1, same: true},
+		},
+		"CredentialsChanged": {
+			reason: "Must replace the cached client if the credentials changed",
+			first:  Config{ProviderConfigName: "default", Credentials: []byte("a")},
+			second: Config{ProviderConfigName: "default", Credentials: []byte("b")},
+			want:   want{created: 2},
+		},
+		"OtherProviderConfig": {
+			reason: "Must cache a client per ProviderConfig",
+			first:  Config{ProviderConfigName: "default", Credentials: []byte("a")},
+			second: Config{ProviderConfigName: "other", Credentials: []byte("a")},
+			want:   want{created: 2},
+		},
+		"NewClientFailed": {
+			reason: "Must return an error if a new client cannot be created",
+			first:  Config{ProviderConfigName: "default", Credentials: []byte("a")},
+			second: Config{ProviderConfigName: "default", Credentials: []byte("b")},
+			newErr: errBoom,
+			want:   want{created: 2, err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := 0
+			c := NewClientCache()
+			c.newClientFn = func(cfg Config) (*github.Client, *http.Client, error) {
+				created++
+				if created > 1 && tc.newErr != nil {
+					return nil, nil, tc.newErr
+				}
+				return github.NewClient(nil), &http.Client{}, nil
+			}
+
+			first, err := c.Get(tc.first)
+			if err != nil {
+				t.Fatalf("\n%s\nGet(...): %s", tc.reason, err)
+			}
+			second, err := c.Get(tc.second)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want created, +got created:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.same, first == second); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want same client, +got same client:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
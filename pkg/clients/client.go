@@ -18,10 +18,16 @@ package clients
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/google/go-github/v33/github"
 	"github.com/pkg/errors"
 	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -31,8 +37,46 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	errEnterpriseURL = "cannot parse GitHub Enterprise Server URL"
+	errProxyURL      = "cannot parse proxy URL"
+	errGetCABundle   = "cannot get CA bundle Secret"
+	errCABundle      = "CA bundle does not contain any PEM encoded certificates"
+)
+
+// Config holds what is needed to build a GitHub client for a ProviderConfig.
+type Config struct {
+	// ProviderConfigName is the name of the ProviderConfig the Config was
+	// read from.
+	ProviderConfigName string
+
+	// Credentials extracted from the ProviderConfig credentials source. They
+	// are a personal access token or, when App is set, the PEM encoded private
+	// key of a GitHub App.
+	Credentials []byte
+
+	// App is the GitHub App installation to authenticate as, if any.
+	App *v1beta1.AppCredentials
+
+	// BaseURL and UploadURL of a GitHub Enterprise Server instance. The
+	// github.com API is used when BaseURL is empty.
+	BaseURL   string
+	UploadURL string
+
+	// ProxyURL of the HTTP(S) proxy used to reach the GitHub API. The proxy
+	// environment variables are honoured when it is empty.
+	ProxyURL string
+
+	// CABundle holds PEM encoded CA certificates trusted in addition to the
+	// system roots.
+	CABundle []byte
+
+	// Timeout of each request to the GitHub API. Zero means no timeout.
+	Timeout time.Duration
+}
+
 // GetConfig gets the config.
-func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) ([]byte, error) {
+func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
@@ -43,18 +87,107 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) ([]byt
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
-	return resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+	if err := updateRateLimitCondition(ctx, c, pc, DefaultRateLimitTracker); err != nil {
+		return nil, err
+	}
+
+	creds, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		ProviderConfigName: pc.GetName(),
+		Credentials:        creds,
+		App:                pc.Spec.Credentials.App,
+		BaseURL:            StringValue(pc.Spec.BaseURL),
+		UploadURL:          StringValue(pc.Spec.UploadURL),
+		ProxyURL:           StringValue(pc.Spec.ProxyURL),
+	}
+	if pc.Spec.Timeout != nil {
+		cfg.Timeout = pc.Spec.Timeout.Duration
+	}
+	if ref := pc.Spec.CABundleSecretRef; ref != nil {
+		s := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetCABundle)
+		}
+		cfg.CABundle = s.Data[ref.Key]
+	}
+	return cfg, nil
 }
 
 // NewClient creates a new client.
-func NewClient(token string) *github.Client {
-	ctx := context.Background()
+func NewClient(cfg Config) (*github.Client, error) {
+	gh, _, err := newClient(cfg)
+	return gh, err
+}
+
+// newClient creates a new client and returns it along with the http.Client
+// underneath its oauth2 transport.
+func newClient(cfg Config) (*github.Client, *http.Client, error) {
+	hc, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
 	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: token},
+		&oauth2.Token{AccessToken: string(cfg.Credentials)},
 	)
-	tc := oauth2.NewClient(ctx, ts)
+	if cfg.App != nil {
+		ts, err = NewAppTokenSource(cfg, hc)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	tc := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: ts,
+			Base:   newETagTransport(DefaultRateLimitTracker.Transport(cfg.ProviderConfigName, hc.Transport)),
+		},
+		Timeout: hc.Timeout,
+	}
 
-	return github.NewClient(tc)
+	gh, err := newGitHubClient(cfg, tc)
+	return gh, hc, err
+}
+
+// newHTTPClient returns the http.Client underneath the oauth2 client. It is
+// configured with the proxy, CA bundle and timeout of the supplied Config.
+func newHTTPClient(cfg Config) (*http.Client, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.ProxyURL != "" {
+		u, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, errors.Wrap(err, errProxyURL)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+	if len(cfg.CABundle) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New(errCABundle)
+		}
+		t.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Transport: t, Timeout: cfg.Timeout}, nil
+}
+
+// newGitHubClient returns a GitHub client that uses the supplied http.Client
+// to talk to github.com, or to the GitHub Enterprise Server instance at the
+// configured BaseURL.
+func newGitHubClient(cfg Config, hc *http.Client) (*github.Client, error) {
+	if cfg.BaseURL == "" {
+		return github.NewClient(hc), nil
+	}
+	upload := cfg.UploadURL
+	if upload == "" {
+		upload = cfg.BaseURL
+	}
+	c, err := github.NewEnterpriseClient(cfg.BaseURL, upload, hc)
+	return c, errors.Wrap(err, errEnterpriseURL)
 }
 
 // StringPtr converts the supplied string to a pointer to that string.
@@ -68,6 +201,16 @@ func StringValue(v *string) string {
 	return *v
 }
 
+// StringSliceValue converts the supplied string slice to a non-nil slice.
+// GitHub requires lists that are part of a request to be present, even if
+// they are empty.
+func StringSliceValue(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
 // ConvertTimestamp converts *github.Timestamp into *metav1.Time
 func ConvertTimestamp(t *github.Timestamp) *metav1.Time {
 	if t == nil {
@@ -101,3 +244,10 @@ func BoolValue(b *bool) bool {
 	}
 	return *b
 }
+
+// IsNotFound returns true if the supplied error is a GitHub API error whose
+// response has the 404 Not Found status code.
+func IsNotFound(err error) bool {
+	var e *github.ErrorResponse
+	return errors.As(err, &e) && e.Response != nil && e.Response.StatusCode == http.StatusNotFound
+}
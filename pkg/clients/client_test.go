@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+)
+
+func TestNewGitHubClient(t *testing.T) {
+	type want struct {
+		baseURL   string
+		uploadURL string
+	}
+	cases := map[string]struct {
+		reason string
+		cfg    Config
+		want   want
+	}{
+		"GitHubDotCom": {
+			reason: "Must use the github.com API if no BaseURL is configured",
+			cfg:    Config{},
+			want: want{
+				baseURL:   "https://api.github.com/",
+				uploadURL: "https://uploads.github.com/",
+			},
+		},
+		"EnterpriseDefaultUploadURL": {
+			reason: "Must use the BaseURL host for uploads if no UploadURL is configured",
+			cfg:    Config{BaseURL: "https://github.example.com"},
+			want: want{
+				baseURL:   "https://github.example.com/api/v3/",
+				uploadURL: "https://github.example.com/api/uploads/",
+			},
+		},
+		"EnterpriseUploadURL": {
+			reason: "Must use the configured UploadURL",
+			cfg: Config{
+				BaseURL:   "https://github.example.com/api/v3/",
+				UploadURL: "https://uploads.github.example.com/",
+			},
+			want: want{
+				baseURL:   "https://github.example.com/api/v3/",
+				uploadURL: "https://uploads.github.example.com/api/uploads/",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := newGitHubClient(tc.cfg, http.DefaultClient)
+			if err != nil {
+				t.Fatalf("\n%s\nnewGitHubClient(...): %s", tc.reason, err)
+			}
+			got := want{baseURL: c.BaseURL.String(), uploadURL: c.UploadURL.String()}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nnewGitHubClient(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	type want struct {
+		proxy   string
+		timeout time.Duration
+		err     error
+	}
+	cases := map[string]struct {
+		reason string
+		cfg    Config
+		want   want
+	}{
+		"Defaults": {
+			reason: "Must not configure a proxy or timeout by default",
+			cfg:    Config{},
+			want:   want{},
+		},
+		"ProxyAndTimeout": {
+			reason: "Must use the configured proxy and timeout",
+			cfg: Config{
+				ProxyURL: "http://proxy.example.com:3128",
+				Timeout:  30 * time.Second,
+			},
+			want: want{
+				proxy:   "http://proxy.example.com:3128",
+				timeout: 30 * time.Second,
+			},
+		},
+		"InvalidCABundle": {
+			reason: "Must return an error if the CA bundle contains no certificates",
+			cfg:    Config{CABundle: []byte("not a certificate")},
+			want:   want{err: errors.New(errCABundle)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			hc, err := newHTTPClient(tc.cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nnewHTTPClient(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.timeout, hc.Timeout); diff != "" {
+				t.Errorf("\n%s\nnewHTTPClient(...): -want timeout, +got timeout:\n%s", tc.reason, diff)
+			}
+			if tc.want.proxy == "" {
+				return
+			}
+			req, _ := http.NewRequest(http.MethodGet, "https://api.github.com/", nil)
+			u, err := hc.Transport.(*http.Transport).Proxy(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.proxy, u.String()); diff != "" {
+				t.Errorf("\n%s\nnewHTTPClient(...): -want proxy, +got proxy:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   bool
+	}{
+		"NotFound": {
+			reason: "Must return true for a 404 Not Found error response",
+			err:    &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			want:   true,
+		},
+		"WrappedNotFound": {
+			reason: "Must return true for a wrapped 404 Not Found error response",
+			err:    errors.Wrap(&github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}, "boom"),
+			want:   true,
+		},
+		"OtherStatus": {
+			reason: "Must return false for other error responses",
+			err:    &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusInternalServerError}},
+		},
+		"OtherError": {
+			reason: "Must return false for errors that are not error responses",
+			err:    errors.New("boom"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNotFound(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsNotFound(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
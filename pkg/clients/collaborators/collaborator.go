@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collaborators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// A PermissionLevel is the permission of a collaborator. The role name, which
+// identifies the maintain, triage and custom repository roles, is not
+// supported by go-github yet.
+type PermissionLevel struct {
+	github.RepositoryPermissionLevel
+	RoleName *string `json:"role_name,omitempty"`
+}
+
+// An Invitation is an invitation to collaborate on a repository. Whether it
+// expired is not supported by go-github yet.
+type Invitation struct {
+	github.RepositoryInvitation
+	Expired *bool `json:"expired,omitempty"`
+}
+
+// Service defines the Repository collaborator operations
+type Service interface {
+	IsCollaborator(ctx context.Context, owner, repo, user string) (bool, *github.Response, error)
+	GetPermissionLevel(ctx context.Context, owner, repo, user string) (*PermissionLevel, *github.Response, error)
+	AddCollaborator(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error)
+	RemoveCollaborator(ctx context.Context, owner, repo, user string) (*github.Response, error)
+	ListInvitations(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*Invitation, *github.Response, error)
+	UpdateInvitation(ctx context.Context, owner, repo string, invitationID int64, permissions string) (*github.RepositoryInvitation, *github.Response, error)
+	DeleteInvitation(ctx context.Context, owner, repo string, invitationID int64) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func (s *service) IsCollaborator(ctx context.Context, owner, repo, user string) (bool, *github.Response, error) {
+	return s.client.Repositories.IsCollaborator(ctx, owner, repo, user)
+}
+
+func (s *service) GetPermissionLevel(ctx context.Context, owner, repo, user string) (*PermissionLevel, *github.Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("repos/%v/%v/collaborators/%v/permission", owner, repo, user), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	p := &PermissionLevel{}
+	res, err := s.client.Do(ctx, req, p)
+	if err != nil {
+		return nil, res, err
+	}
+	return p, res, nil
+}
+
+func (s *service) AddCollaborator(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+	return s.client.Repositories.AddCollaborator(ctx, owner, repo, user, opts)
+}
+
+func (s *service) RemoveCollaborator(ctx context.Context, owner, repo, user string) (*github.Response, error) {
+	return s.client.Repositories.RemoveCollaborator(ctx, owner, repo, user)
+}
+
+func (s *service) ListInvitations(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*Invitation, *github.Response, error) {
+	q := url.Values{}
+	if opts != nil && opts.Page != 0 {
+		q.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts != nil && opts.PerPage != 0 {
+		q.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	u := url.URL{Path: fmt.Sprintf("repos/%v/%v/invitations", owner, repo), RawQuery: q.Encode()}
+	req, err := s.client.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var invites []*Invitation
+	res, err := s.client.Do(ctx, req, &invites)
+	if err != nil {
+		return nil, res, err
+	}
+	return invites, res, nil
+}
+
+func (s *service) UpdateInvitation(ctx context.Context, owner, repo string, invitationID int64, permissions string) (*github.RepositoryInvitation, *github.Response, error) {
+	return s.client.Repositories.UpdateInvitation(ctx, owner, repo, invitationID, permissions)
+}
+
+func (s *service) DeleteInvitation(ctx context.Context, owner, repo string, invitationID int64) (*github.Response, error) {
+	return s.client.Repositories.DeleteInvitation(ctx, owner, repo, invitationID)
+}
+
+// FindInvitation returns the open invitation of the supplied user to
+// collaborate on a repository, or nil if there is none.
+func FindInvitation(ctx context.Context, s Service, owner, repo, user string) (*Invitation, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		invites, res, err := s.ListInvitations(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range invites {
+			if strings.EqualFold(i.GetInvitee().GetLogin(), user) {
+				return i, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+// Permission returns the permission of a collaborator in the form it is
+// granted in. Custom repository roles are returned by name.
+func Permission(p *PermissionLevel) string {
+	if name := ghclient.StringValue(p.RoleName); name != "" {
+		return ghclient.RepositoryPermission(name)
+	}
+	return ghclient.RepositoryPermission(p.GetPermission())
+}
+
+// IsUpToDate checks whether the supplied permission is the one given in
+// RepositoryCollaboratorParameters.
+func IsUpToDate(p v1alpha1.RepositoryCollaboratorParameters, permission string) bool {
+	return p.Permission == nil || *p.Permission == permission
+}
+
+// LateInitialize fills the empty fields of RepositoryCollaboratorParameters if
+// the corresponding fields are observed.
+func LateInitialize(p *v1alpha1.RepositoryCollaboratorParameters, permission string) {
+	if p.Permission == nil && permission != "" {
+		p.Permission = ghclient.StringPtr(permission)
+	}
+}
+
+// GenerateCollaboratorObservation produces RepositoryCollaboratorObservation
+// object for an active collaborator.
+func GenerateCollaboratorObservation(p *PermissionLevel) v1alpha1.RepositoryCollaboratorObservation {
+	return v1alpha1.RepositoryCollaboratorObservation{
+		State:      v1alpha1.CollaboratorStateActive,
+		Permission: Permission(p),
+	}
+}
+
+// GenerateInvitationObservation produces RepositoryCollaboratorObservation
+// object for an invited collaborator.
+func GenerateInvitationObservation(i *Invitation) v1alpha1.RepositoryCollaboratorObservation {
+	o := v1alpha1.RepositoryCollaboratorObservation{
+		State:         v1alpha1.CollaboratorStatePending,
+		Permission:    ghclient.RepositoryPermission(i.GetPermissions()),
+		InvitationID:  i.GetID(),
+		InvitationURL: i.GetHTMLURL(),
+	}
+	if ghclient.BoolValue(i.Expired) {
+		o.State = v1alpha1.CollaboratorStateExpired
+	}
+	return o
+}
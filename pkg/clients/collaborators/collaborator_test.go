@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collaborators
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func TestFindInvitation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`[{"id":1,"invitee":{"login":"hubot"}}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":2,"invitee":{"login":"Octocat"},"permissions":"write","expired":true}]`))
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	got, err := FindInvitation(context.Background(), &service{client: gh}, "crossplane", "sample", "octocat")
+	if err != nil {
+		t.Fatalf("FindInvitation(...): %s", err)
+	}
+	want := v1alpha1.RepositoryCollaboratorObservation{
+		State:        v1alpha1.CollaboratorStateExpired,
+		Permission:   "push",
+		InvitationID: 2,
+	}
+	if diff := cmp.Diff(want, GenerateInvitationObservation(got)); diff != "" {
+		t.Errorf("FindInvitation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestPermission(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      *PermissionLevel
+		want   string
+	}{
+		"RoleName": {
+			reason: "Must prefer the role name, which distinguishes maintain from push",
+			p: &PermissionLevel{
+				RepositoryPermissionLevel: github.RepositoryPermissionLevel{Permission: github.String("write")},
+				RoleName:                  github.String("maintain"),
+			},
+			want: "maintain",
+		},
+		"CustomRole": {
+			reason: "Must return custom roles by name",
+			p: &PermissionLevel{
+				RepositoryPermissionLevel: github.RepositoryPermissionLevel{Permission: github.String("read")},
+				RoleName:                  github.String("security-engineer"),
+			},
+			want: "security-engineer",
+		},
+		"Permission": {
+			reason: "Must fall back to the legacy permission if there is no role name",
+			p: &PermissionLevel{
+				RepositoryPermissionLevel: github.RepositoryPermissionLevel{Permission: github.String("read")},
+			},
+			want: "pull",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Permission(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPermission(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	push := "push"
+	admin := "admin"
+	cases := map[string]struct {
+		reason     string
+		p          *v1alpha1.RepositoryCollaboratorParameters
+		permission string
+		want       *v1alpha1.RepositoryCollaboratorParameters
+	}{
+		"Empty": {
+			reason:     "Must late initialize the permission",
+			p:          &v1alpha1.RepositoryCollaboratorParameters{},
+			permission: push,
+			want:       &v1alpha1.RepositoryCollaboratorParameters{Permission: &push},
+		},
+		"Set": {
+			reason:     "Must not override the desired permission",
+			p:          &v1alpha1.RepositoryCollaboratorParameters{Permission: &admin},
+			permission: push,
+			want:       &v1alpha1.RepositoryCollaboratorParameters{Permission: &admin},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, tc.permission)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("\n%s\nLateInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errGetConfigMap    = "cannot get ConfigMap"
+	errNoConfigMapData = "ConfigMap does not have the referenced key"
+)
+
+// GetConfigMapValue returns the value of the supplied key of a ConfigMap.
+func GetConfigMapValue(ctx context.Context, c client.Reader, namespace, name, key string) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+		return "", errors.Wrap(err, errGetConfigMap)
+	}
+	v, ok := cm.Data[key]
+	if !ok {
+		return "", errors.New(errNoConfigMapData)
+	}
+	return v, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploykeys
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+
+	"github.com/google/go-github/v33/github"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// Service defines the Repository deploy key operations
+type Service interface {
+	GetKey(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error)
+	CreateKey(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error)
+	DeleteKey(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(c.Repositories)
+	return &s, nil
+}
+
+// GenerateKey produces github.Key from DeployKeyParameters.
+func GenerateKey(p v1alpha1.DeployKeyParameters) *github.Key {
+	k := &github.Key{
+		Title:    github.String(p.Title),
+		Key:      p.Key,
+		ReadOnly: p.ReadOnly,
+	}
+	if k.ReadOnly == nil {
+		k.ReadOnly = github.Bool(true)
+	}
+	return k
+}
+
+// LateInitialize fills the empty fields of DeployKeyParameters if the
+// corresponding fields are given in github.Key. The key is not filled, since
+// an empty key marks a generated key pair.
+func LateInitialize(p *v1alpha1.DeployKeyParameters, k *github.Key) {
+	if p.ReadOnly == nil && k.ReadOnly != nil {
+		p.ReadOnly = github.Bool(k.GetReadOnly())
+	}
+}
+
+// GenerateObservation produces DeployKeyObservation object from github.Key
+// object.
+func GenerateObservation(k *github.Key) v1alpha1.DeployKeyObservation {
+	o := v1alpha1.DeployKeyObservation{
+		ID:        k.GetID(),
+		URL:       k.GetURL(),
+		CreatedAt: ghclient.ConvertTimestamp(k.CreatedAt),
+	}
+	if pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k.GetKey())); err == nil {
+		o.Fingerprint = ssh.FingerprintSHA256(pub)
+	}
+	return o
+}
+
+// GenerateKeyPair generates an ed25519 key pair with randomness from the
+// supplied reader. It returns the public key in the authorized_keys format
+// and the PEM encoded private key in the OpenSSH format.
+func GenerateKeyPair(rand io.Reader) (publicKey, privateKey []byte, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, nil, err
+	}
+	check := make([]byte, 4)
+	if _, err := io.ReadFull(rand, check); err != nil {
+		return nil, nil, err
+	}
+	publicKey = bytes.TrimSuffix(ssh.MarshalAuthorizedKey(sshPub), []byte("\n"))
+	privateKey = pem.EncodeToMemory(&pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: marshalOpenSSHPrivateKey(sshPub, priv, binary.BigEndian.Uint32(check)),
+	})
+	return publicKey, privateKey, nil
+}
+
+// marshalOpenSSHPrivateKey encodes an unencrypted ed25519 private key in the
+// format of OpenSSH. golang.org/x/crypto/ssh parses the format, but cannot
+// marshal it yet. See
+// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.key
+func marshalOpenSSHPrivateKey(pub ssh.PublicKey, priv ed25519.PrivateKey, check uint32) []byte {
+	key := struct {
+		Check1  uint32
+		Check2  uint32
+		Keytype string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}{
+		Check1:  check,
+		Check2:  check,
+		Keytype: ssh.KeyAlgoED25519,
+		Pub:     priv.Public().(ed25519.PublicKey),
+		Priv:    priv,
+	}
+
+	// The private keys are padded to the block size of the cipher, which is
+	// 8 for none.
+	n := len(ssh.Marshal(key)) % 8
+	for i := 0; n != 0 && i < 8-n; i++ {
+		key.Pad = append(key.Pad, byte(i+1))
+	}
+
+	w := struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       pub.Marshal(),
+		PrivKeyBlock: ssh.Marshal(key),
+	}
+	return append([]byte("openssh-key-v1\x00"), ssh.Marshal(w)...)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploykeys
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+var fakePublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+
+func TestGenerateKeyPair(t *testing.T) {
+	pub, priv, err := GenerateKeyPair(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKeyPair(...): unexpected error: %v", err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(priv)
+	if err != nil {
+		t.Fatalf("GenerateKeyPair(...): cannot parse private key: %v", err)
+	}
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey(pub)
+	if err != nil {
+		t.Fatalf("GenerateKeyPair(...): cannot parse public key: %v", err)
+	}
+	if diff := cmp.Diff(parsed.Marshal(), signer.PublicKey().Marshal()); diff != "" {
+		t.Errorf("GenerateKeyPair(...): -public key, +public key of private key:\n%s", diff)
+	}
+	if diff := cmp.Diff(ssh.KeyAlgoED25519, parsed.Type()); diff != "" {
+		t.Errorf("GenerateKeyPair(...): -want type, +got type:\n%s", diff)
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.DeployKeyParameters
+		want   *github.Key
+	}{
+		"ReadOnlyByDefault": {
+			reason: "Deploy keys must be read-only if not given otherwise",
+			p:      v1alpha1.DeployKeyParameters{Title: "argocd", Key: &fakePublicKey},
+			want:   &github.Key{Title: github.String("argocd"), Key: &fakePublicKey, ReadOnly: github.Bool(true)},
+		},
+		"ReadWrite": {
+			reason: "Must allow write access if the key is not read-only",
+			p:      v1alpha1.DeployKeyParameters{Title: "release", Key: &fakePublicKey, ReadOnly: github.Bool(false)},
+			want:   &github.Key{Title: github.String("release"), Key: &fakePublicKey, ReadOnly: github.Bool(false)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateKey(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateKey(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(fakePublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := v1alpha1.DeployKeyObservation{
+		ID:          42,
+		URL:         "https://api.github.com/repos/crossplane/sample/keys/42",
+		Fingerprint: ssh.FingerprintSHA256(pub),
+	}
+	got := GenerateObservation(&github.Key{
+		ID:  github.Int64(42),
+		URL: github.String("https://api.github.com/repos/crossplane/sample/keys/42"),
+		Key: &fakePublicKey,
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nGenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
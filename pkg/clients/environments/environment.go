@@ -0,0 +1,454 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// Types of the protection rules and reviewers of an environment.
+const (
+	RuleTypeWaitTimer         = "wait_timer"
+	RuleTypeRequiredReviewers = "required_reviewers"
+
+	ReviewerTypeUser = "User"
+	ReviewerTypeTeam = "Team"
+
+	// PatternTypeBranch is the default type of a deployment branch policy.
+	PatternTypeBranch = "branch"
+)
+
+// An Environment is a deployment environment of a repository. Environments
+// are not supported by go-github yet.
+type Environment struct {
+	ID                     int64             `json:"id,omitempty"`
+	NodeID                 string            `json:"node_id,omitempty"`
+	Name                   string            `json:"name,omitempty"`
+	URL                    string            `json:"url,omitempty"`
+	HTMLURL                string            `json:"html_url,omitempty"`
+	CreatedAt              *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt              *github.Timestamp `json:"updated_at,omitempty"`
+	ProtectionRules        []*ProtectionRule `json:"protection_rules,omitempty"`
+	DeploymentBranchPolicy *BranchPolicy     `json:"deployment_branch_policy,omitempty"`
+}
+
+// A ProtectionRule is a protection rule of an environment. Which fields are
+// set depends on its type.
+type ProtectionRule struct {
+	ID                int64       `json:"id,omitempty"`
+	Type              string      `json:"type,omitempty"`
+	WaitTimer         int         `json:"wait_timer,omitempty"`
+	PreventSelfReview bool        `json:"prevent_self_review,omitempty"`
+	Reviewers         []*Reviewer `json:"reviewers,omitempty"`
+}
+
+// A Reviewer is a user or a team who can approve deployments to an
+// environment.
+type Reviewer struct {
+	Type     string           `json:"type"`
+	Reviewer *ReviewerAccount `json:"reviewer,omitempty"`
+}
+
+// A ReviewerAccount is the user or the team of a Reviewer.
+type ReviewerAccount struct {
+	ID    int64  `json:"id,omitempty"`
+	Login string `json:"login,omitempty"`
+	Slug  string `json:"slug,omitempty"`
+}
+
+// A BranchPolicy defines whether protected branches or the custom deployment
+// branch policies can deploy to an environment.
+type BranchPolicy struct {
+	ProtectedBranches    bool `json:"protected_branches"`
+	CustomBranchPolicies bool `json:"custom_branch_policies"`
+}
+
+// A DeploymentBranchPolicy is a name pattern of the branches or tags that can
+// deploy to an environment.
+type DeploymentBranchPolicy struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// A DeploymentBranchPolicyList is a page of DeploymentBranchPolicies.
+type DeploymentBranchPolicyList struct {
+	TotalCount     int                       `json:"total_count"`
+	BranchPolicies []*DeploymentBranchPolicy `json:"branch_policies"`
+}
+
+// CreateUpdateEnvironment is the request to create or update an environment.
+// Reviewers and the branch policy are removed when they are null.
+type CreateUpdateEnvironment struct {
+	WaitTimer              *int                   `json:"wait_timer,omitempty"`
+	PreventSelfReview      *bool                  `json:"prevent_self_review,omitempty"`
+	Reviewers              []*EnvironmentReviewer `json:"reviewers"`
+	DeploymentBranchPolicy *BranchPolicy          `json:"deployment_branch_policy"`
+}
+
+// An EnvironmentReviewer identifies a reviewer by its type and ID when an
+// environment is created or updated.
+type EnvironmentReviewer struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id"`
+}
+
+// Service defines the Environment operations
+type Service interface {
+	GetEnvironment(ctx context.Context, owner, repo, name string) (*Environment, *github.Response, error)
+	CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, e *CreateUpdateEnvironment) (*Environment, *github.Response, error)
+	DeleteEnvironment(ctx context.Context, owner, repo, name string) (*github.Response, error)
+	ListDeploymentBranchPolicies(ctx context.Context, owner, repo, env string, opts *github.ListOptions) (*DeploymentBranchPolicyList, *github.Response, error)
+	CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, env string, p *DeploymentBranchPolicy) (*DeploymentBranchPolicy, *github.Response, error)
+	DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, env string, id int64) (*github.Response, error)
+	GetUser(ctx context.Context, user string) (*github.User, *github.Response, error)
+	GetTeamBySlug(ctx context.Context, org, slug string) (*github.Team, *github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func environment(owner, repo, name string) string {
+	return fmt.Sprintf("repos/%v/%v/environments/%v", owner, repo, url.PathEscape(name))
+}
+
+func branchPolicies(owner, repo, env string) string {
+	return fmt.Sprintf("%v/deployment-branch-policies", environment(owner, repo, env))
+}
+
+func (s *service) GetEnvironment(ctx context.Context, owner, repo, name string) (*Environment, *github.Response, error) {
+	req, err := s.client.NewRequest("GET", environment(owner, repo, name), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	e := &Environment{}
+	res, err := s.client.Do(ctx, req, e)
+	if err != nil {
+		return nil, res, err
+	}
+	return e, res, nil
+}
+
+func (s *service) CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, e *CreateUpdateEnvironment) (*Environment, *github.Response, error) {
+	req, err := s.client.NewRequest("PUT", environment(owner, repo, name), e)
+	if err != nil {
+		return nil, nil, err
+	}
+	env := &Environment{}
+	res, err := s.client.Do(ctx, req, env)
+	if err != nil {
+		return nil, res, err
+	}
+	return env, res, nil
+}
+
+func (s *service) DeleteEnvironment(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	req, err := s.client.NewRequest("DELETE", environment(owner, repo, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *service) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, env string, opts *github.ListOptions) (*DeploymentBranchPolicyList, *github.Response, error) {
+	q := url.Values{}
+	if opts != nil && opts.Page != 0 {
+		q.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts != nil && opts.PerPage != 0 {
+		q.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	u := url.URL{Path: branchPolicies(owner, repo, env), RawQuery: q.Encode()}
+	req, err := s.client.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	l := &DeploymentBranchPolicyList{}
+	res, err := s.client.Do(ctx, req, l)
+	if err != nil {
+		return nil, res, err
+	}
+	return l, res, nil
+}
+
+func (s *service) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, env string, p *DeploymentBranchPolicy) (*DeploymentBranchPolicy, *github.Response, error) {
+	req, err := s.client.NewRequest("POST", branchPolicies(owner, repo, env), p)
+	if err != nil {
+		return nil, nil, err
+	}
+	created := &DeploymentBranchPolicy{}
+	res, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, res, err
+	}
+	return created, res, nil
+}
+
+func (s *service) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, env string, id int64) (*github.Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("%v/%v", branchPolicies(owner, repo, env), id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *service) GetUser(ctx context.Context, user string) (*github.User, *github.Response, error) {
+	return s.client.Users.Get(ctx, user)
+}
+
+func (s *service) GetTeamBySlug(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+	return s.client.Teams.GetTeamBySlug(ctx, org, slug)
+}
+
+// ListBranchPolicies returns all deployment branch policies of the supplied
+// environment.
+func ListBranchPolicies(ctx context.Context, s Service, owner, repo, env string) ([]*DeploymentBranchPolicy, error) {
+	var policies []*DeploymentBranchPolicy
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		l, res, err := s.ListDeploymentBranchPolicies(ctx, owner, repo, env, opts)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, l.BranchPolicies...)
+		if res == nil || res.NextPage == 0 {
+			return policies, nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+// GenerateReviewers looks up the IDs of the reviewers given in
+// EnvironmentParameters. Teams are looked up in the organization that owns
+// the repository.
+func GenerateReviewers(ctx context.Context, s Service, p v1alpha1.EnvironmentParameters) ([]*EnvironmentReviewer, error) {
+	reviewers := make([]*EnvironmentReviewer, 0, len(p.Reviewers))
+	for _, r := range p.Reviewers {
+		switch {
+		case r.User != nil:
+			u, _, err := s.GetUser(ctx, *r.User)
+			if err != nil {
+				return nil, err
+			}
+			reviewers = append(reviewers, &EnvironmentReviewer{Type: ReviewerTypeUser, ID: u.GetID()})
+		case r.Team != nil:
+			t, _, err := s.GetTeamBySlug(ctx, p.Owner, *r.Team)
+			if err != nil {
+				return nil, err
+			}
+			reviewers = append(reviewers, &EnvironmentReviewer{Type: ReviewerTypeTeam, ID: t.GetID()})
+		}
+	}
+	return reviewers, nil
+}
+
+// GenerateCreateUpdateEnvironment produces CreateUpdateEnvironment object
+// from EnvironmentParameters object and the reviewers of the environment.
+func GenerateCreateUpdateEnvironment(p v1alpha1.EnvironmentParameters, reviewers []*EnvironmentReviewer) *CreateUpdateEnvironment {
+	e := &CreateUpdateEnvironment{
+		WaitTimer: p.WaitTimer,
+	}
+	if len(reviewers) > 0 {
+		e.Reviewers = reviewers
+		e.PreventSelfReview = p.PreventSelfReview
+	}
+	if bp := p.DeploymentBranchPolicy; bp != nil {
+		e.DeploymentBranchPolicy = &BranchPolicy{
+			ProtectedBranches:    bp.ProtectedBranches,
+			CustomBranchPolicies: !bp.ProtectedBranches,
+		}
+	}
+	return e
+}
+
+// HasCustomBranchPolicies reports whether the deployment branch policies of
+// the supplied environment are in effect.
+func HasCustomBranchPolicies(e *Environment) bool {
+	return e.DeploymentBranchPolicy != nil && e.DeploymentBranchPolicy.CustomBranchPolicies
+}
+
+// DiffBranchPolicies returns the deployment branch policies that have to be
+// created and deleted so that the observed ones match the given patterns.
+func DiffBranchPolicies(patterns []v1alpha1.DeploymentBranchPattern, policies []*DeploymentBranchPolicy) ([]*DeploymentBranchPolicy, []*DeploymentBranchPolicy) {
+	observed := make(map[string]bool, len(policies))
+	for _, bp := range policies {
+		observed[policyKey(bp.Type, bp.Name)] = true
+	}
+	desired := make(map[string]bool, len(patterns))
+	var create, remove []*DeploymentBranchPolicy
+	for _, pt := range patterns {
+		t := patternType(pt.Type)
+		k := policyKey(t, pt.Name)
+		if !observed[k] && !desired[k] {
+			create = append(create, &DeploymentBranchPolicy{Name: pt.Name, Type: t})
+		}
+		desired[k] = true
+	}
+	for _, bp := range policies {
+		if !desired[policyKey(bp.Type, bp.Name)] {
+			remove = append(remove, bp)
+		}
+	}
+	return create, remove
+}
+
+func patternType(t *string) string {
+	if t == nil {
+		return PatternTypeBranch
+	}
+	return *t
+}
+
+func policyKey(t, name string) string {
+	if t == "" {
+		t = PatternTypeBranch
+	}
+	return t + "/" + name
+}
+
+func reviewerKey(t, name string) string {
+	return t + "/" + strings.ToLower(name)
+}
+
+// rule returns the protection rule of the supplied type, or nil if the
+// environment does not have one.
+func rule(e *Environment, t string) *ProtectionRule {
+	for _, r := range e.ProtectionRules {
+		if r.Type == t {
+			return r
+		}
+	}
+	return nil
+}
+
+func areReviewersUpToDate(reviewers []v1alpha1.EnvironmentReviewer, r *ProtectionRule) bool {
+	desired := map[string]bool{}
+	for _, rv := range reviewers {
+		switch {
+		case rv.User != nil:
+			desired[reviewerKey(ReviewerTypeUser, *rv.User)] = true
+		case rv.Team != nil:
+			desired[reviewerKey(ReviewerTypeTeam, *rv.Team)] = true
+		}
+	}
+	observed := map[string]bool{}
+	if r != nil {
+		for _, rv := range r.Reviewers {
+			if rv.Reviewer == nil {
+				continue
+			}
+			name := rv.Reviewer.Login
+			if rv.Type == ReviewerTypeTeam {
+				name = rv.Reviewer.Slug
+			}
+			observed[reviewerKey(rv.Type, name)] = true
+		}
+	}
+	if len(desired) != len(observed) {
+		return false
+	}
+	for k := range desired {
+		if !observed[k] {
+			return false
+		}
+	}
+	return true
+}
+
+func isBranchPolicyUpToDate(bp *v1alpha1.DeploymentBranchPolicy, e *Environment, policies []*DeploymentBranchPolicy) bool {
+	if bp == nil || e.DeploymentBranchPolicy == nil {
+		return bp == nil && e.DeploymentBranchPolicy == nil
+	}
+	if bp.ProtectedBranches != e.DeploymentBranchPolicy.ProtectedBranches {
+		return false
+	}
+	if bp.ProtectedBranches {
+		return true
+	}
+	create, remove := DiffBranchPolicies(bp.Patterns, policies)
+	return len(create) == 0 && len(remove) == 0
+}
+
+// IsUpToDate checks whether the supplied Environment and its deployment
+// branch policies are configured as given in EnvironmentParameters.
+func IsUpToDate(p v1alpha1.EnvironmentParameters, e *Environment, policies []*DeploymentBranchPolicy) bool {
+	waitTimer := 0
+	if r := rule(e, RuleTypeWaitTimer); r != nil {
+		waitTimer = r.WaitTimer
+	}
+	if p.WaitTimer != nil && *p.WaitTimer != waitTimer {
+		return false
+	}
+	reviewers := rule(e, RuleTypeRequiredReviewers)
+	if !areReviewersUpToDate(p.Reviewers, reviewers) {
+		return false
+	}
+	// Self-review can only be prevented when there are reviewers.
+	if reviewers != nil && p.PreventSelfReview != nil && *p.PreventSelfReview != reviewers.PreventSelfReview {
+		return false
+	}
+	return isBranchPolicyUpToDate(p.DeploymentBranchPolicy, e, policies)
+}
+
+// LateInitialize fills the empty fields of EnvironmentParameters if the
+// corresponding fields are observed.
+func LateInitialize(p *v1alpha1.EnvironmentParameters, e *Environment) {
+	if p.WaitTimer == nil {
+		waitTimer := 0
+		if r := rule(e, RuleTypeWaitTimer); r != nil {
+			waitTimer = r.WaitTimer
+		}
+		p.WaitTimer = &waitTimer
+	}
+	if r := rule(e, RuleTypeRequiredReviewers); r != nil && p.PreventSelfReview == nil {
+		p.PreventSelfReview = github.Bool(r.PreventSelfReview)
+	}
+}
+
+// GenerateObservation produces EnvironmentObservation object from
+// Environment object.
+func GenerateObservation(e *Environment) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		ID:        e.ID,
+		NodeID:    e.NodeID,
+		URL:       e.URL,
+		HTMLURL:   e.HTMLURL,
+		CreatedAt: ghclient.ConvertTimestamp(e.CreatedAt),
+		UpdatedAt: ghclient.ConvertTimestamp(e.UpdatedAt),
+	}
+}
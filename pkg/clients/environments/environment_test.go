@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func TestDiffBranchPolicies(t *testing.T) {
+	type args struct {
+		patterns []v1alpha1.DeploymentBranchPattern
+		policies []*DeploymentBranchPolicy
+	}
+	type want struct {
+		create []*DeploymentBranchPolicy
+		remove []*DeploymentBranchPolicy
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				patterns: []v1alpha1.DeploymentBranchPattern{
+					{Name: "main"},
+					{Name: "v*", Type: github.String("tag")},
+				},
+				policies: []*DeploymentBranchPolicy{
+					{ID: 1, Name: "main", Type: "branch"},
+					{ID: 2, Name: "v*", Type: "tag"},
+				},
+			},
+			want: want{},
+		},
+		"TypeChanged": {
+			args: args{
+				patterns: []v1alpha1.DeploymentBranchPattern{
+					{Name: "v*", Type: github.String("tag")},
+				},
+				policies: []*DeploymentBranchPolicy{
+					{ID: 1, Name: "v*"},
+				},
+			},
+			want: want{
+				create: []*DeploymentBranchPolicy{{Name: "v*", Type: "tag"}},
+				remove: []*DeploymentBranchPolicy{{ID: 1, Name: "v*"}},
+			},
+		},
+		"DuplicatePattern": {
+			args: args{
+				patterns: []v1alpha1.DeploymentBranchPattern{
+					{Name: "main"},
+					{Name: "main", Type: github.String("branch")},
+				},
+			},
+			want: want{
+				create: []*DeploymentBranchPolicy{{Name: "main", Type: "branch"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, remove := DiffBranchPolicies(tc.args.patterns, tc.args.policies)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("DiffBranchPolicies(...): -want create, +got create:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("DiffBranchPolicies(...): -want remove, +got remove:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	reviewers := &ProtectionRule{
+		Type: RuleTypeRequiredReviewers,
+		Reviewers: []*Reviewer{
+			{Type: ReviewerTypeTeam, Reviewer: &ReviewerAccount{ID: 1, Slug: "sre"}},
+		},
+	}
+
+	type args struct {
+		p        v1alpha1.EnvironmentParameters
+		e        *Environment
+		policies []*DeploymentBranchPolicy
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Empty": {
+			args: args{
+				p: v1alpha1.EnvironmentParameters{WaitTimer: github.Int(0)},
+				e: &Environment{},
+			},
+			want: true,
+		},
+		"WaitTimerChanged": {
+			args: args{
+				p: v1alpha1.EnvironmentParameters{WaitTimer: github.Int(0)},
+				e: &Environment{ProtectionRules: []*ProtectionRule{{Type: RuleTypeWaitTimer, WaitTimer: 5}}},
+			},
+			want: false,
+		},
+		"SelfReviewWithoutReviewers": {
+			args: args{
+				p: v1alpha1.EnvironmentParameters{PreventSelfReview: github.Bool(true)},
+				e: &Environment{},
+			},
+			want: true,
+		},
+		"SelfReviewChanged": {
+			args: args{
+				p: v1alpha1.EnvironmentParameters{
+					Reviewers:         []v1alpha1.EnvironmentReviewer{{Team: github.String("SRE")}},
+					PreventSelfReview: github.Bool(true),
+				},
+				e: &Environment{ProtectionRules: []*ProtectionRule{reviewers}},
+			},
+			want: false,
+		},
+		"BranchPolicyRemoved": {
+			args: args{
+				p: v1alpha1.EnvironmentParameters{},
+				e: &Environment{DeploymentBranchPolicy: &BranchPolicy{ProtectedBranches: true}},
+			},
+			want: false,
+		},
+		"ProtectedBranches": {
+			args: args{
+				p: v1alpha1.EnvironmentParameters{
+					DeploymentBranchPolicy: &v1alpha1.DeploymentBranchPolicy{ProtectedBranches: true},
+				},
+				e: &Environment{DeploymentBranchPolicy: &BranchPolicy{ProtectedBranches: true}},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.args.p, tc.args.e, tc.args.policies)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
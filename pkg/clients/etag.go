@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+
+	// Bounds of the memory used by the ETag cache of each client.
+	etagCacheMaxBytes      = 16 << 20
+	etagCacheMaxEntryBytes = 1 << 20
+)
+
+// newETagTransport returns an http.RoundTripper that makes GET requests
+// conditional on the ETag of the last response to the same request. GitHub
+// does not count 304 Not Modified responses against the rate limit. They are
+// answered with the cached response instead.
+func newETagTransport(base http.RoundTripper) http.RoundTripper {
+	return &etagTransport{base: base, cache: newETagCache(etagCacheMaxBytes, etagCacheMaxEntryBytes)}
+}
+
+type etagTransport struct {
+	base  http.RoundTripper
+	cache *etagCache
+}
+
+// RoundTrip sends the supplied request, conditionally if it is cached.
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	key := req.URL.String() + " " + req.Header.Get("Accept")
+	cached, ok := t.cache.Get(key)
+	if ok {
+		r := req.Clone(req.Context())
+		r.Header.Set(headerIfNoneMatch, cached.etag)
+		req = r
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ok && res.StatusCode == http.StatusNotModified:
+		_, _ = io.Copy(ioutil.Discard, res.Body)
+		_ = res.Body.Close()
+		return cached.response(req, res.Header), nil
+	case res.StatusCode == http.StatusOK && res.Header.Get(headerETag) != "":
+		return t.store(key, res)
+	}
+	return res, nil
+}
+
+// store caches the supplied response if its body fits in a cache entry. The
+// body of the returned response is readable whether it was cached or not.
+func (t *etagTransport) store(key string, res *http.Response) (*http.Response, error) {
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, t.cache.maxEntryBytes+1))
+	if err != nil {
+		_ = res.Body.Close()
+		return nil, err
+	}
+	if int64(len(body)) > t.cache.maxEntryBytes {
+		res.Body = &readCloser{Reader: io.MultiReader(bytes.NewReader(body), res.Body), Closer: res.Body}
+		return res, nil
+	}
+	_ = res.Body.Close()
+
+	t.cache.Add(key, &etagEntry{
+		etag:   res.Header.Get(headerETag),
+		status: res.StatusCode,
+		header: res.Header.Clone(),
+		body:   body,
+	})
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// An etagEntry is a cached response.
+type etagEntry struct {
+	key    string
+	etag   string
+	status int
+	header http.Header
+	body   []byte
+}
+
+// response returns the cached response to the supplied request. The rate limit
+// headers of the supplied 304 Not Modified response are carried over.
+func (e *etagEntry) response(req *http.Request, notModified http.Header) *http.Response {
+	h := e.header.Clone()
+	for _, k := range []string{headerRateLimit, headerRateRemaining, headerRateReset, headerRateResource} {
+		if v := notModified.Get(k); v != "" {
+			h.Set(k, v)
+		}
+	}
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        h,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// An etagCache is a least recently used cache of responses, bounded by the
+// total size of their bodies.
+type etagCache struct {
+	mu            sync.Mutex
+	maxBytes      int64
+	maxEntryBytes int64
+	size          int64
+	ll            *list.List
+	entries       map[string]*list.Element
+}
+
+func newETagCache(maxBytes, maxEntryBytes int64) *etagCache {
+	return &etagCache{
+		maxBytes:      maxBytes,
+		maxEntryBytes: maxEntryBytes,
+		ll:            list.New(),
+		entries:       map[string]*list.Element{},
+	}
+}
+
+// Get the cached response for the supplied key.
+func (c *etagCache) Get(key string) (*etagEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*etagEntry), true
+}
+
+// Add the supplied response to the cache, evicting the least recently used
+// responses until the cache is within its bounds.
+func (c *etagCache) Add(key string, e *etagEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e.key = key
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	c.entries[key] = c.ll.PushFront(e)
+	c.size += int64(len(e.body))
+
+	for c.size > c.maxBytes {
+		c.remove(c.ll.Back())
+	}
+}
+
+func (c *etagCache) remove(el *list.Element) {
+	e := c.ll.Remove(el).(*etagEntry)
+	delete(c.entries, e.key)
+	c.size -= int64(len(e.body))
+}
+
+// Len returns the number of cached responses.
+func (c *etagCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
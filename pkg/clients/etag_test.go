@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+)
+
+func TestETagTransport(t *testing.T) {
+	etag := `"v1"`
+	notModified := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headerIfNoneMatch) == etag {
+			notModified++
+			w.Header().Set(headerRateRemaining, "4999")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(headerETag, etag)
+		w.Header().Set(headerRateRemaining, "4998")
+		fmt.Fprintf(w, `{"name":"provider-github","description":%q}`, etag)
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(&http.Client{Transport: newETagTransport(http.DefaultTransport)})
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	get := func() *github.Repository {
+		r, res, err := gh.Repositories.Get(context.Background(), "crossplane", "provider-github")
+		if err != nil {
+			t.Fatalf("Get(...): %s", err)
+		}
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("Get(...): want status %d, got %d", http.StatusOK, res.StatusCode)
+		}
+		return r
+	}
+
+	first := get()
+	second := get()
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("Get(...): -want cached, +got cached:\n%s", diff)
+	}
+	if diff := cmp.Diff(1, notModified); diff != "" {
+		t.Errorf("Get(...): -want not modified, +got not modified:\n%s", diff)
+	}
+
+	etag = `"v2"`
+	third := get()
+	if diff := cmp.Diff(`"v2"`, third.GetDescription()); diff != "" {
+		t.Errorf("Get(...): -want changed, +got changed:\n%s", diff)
+	}
+}
+
+func TestETagCacheBounds(t *testing.T) {
+	c := newETagCache(10, 6)
+	c.Add("a", &etagEntry{body: []byte("aaaa")})
+	c.Add("b", &etagEntry{body: []byte("bbbb")})
+
+	// Using a makes b the least recently used entry, evicted to make room.
+	c.Get("a")
+	c.Add("c", &etagEntry{body: []byte("cccc")})
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Add(...): want least recently used entry evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("Add(...): want recently used entry kept")
+	}
+	if diff := cmp.Diff(2, c.Len()); diff != "" {
+		t.Errorf("Add(...): -want entries, +got entries:\n%s", diff)
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labels
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// A LabelEdit is the request to edit a label. go-github sends the new name of
+// a label as its name, which GitHub does not rename the label with.
+type LabelEdit struct {
+	NewName     string  `json:"new_name,omitempty"`
+	Color       string  `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// A LabelUpdate is an edit of an existing label, identified by its current
+// name.
+type LabelUpdate struct {
+	Name string
+	Edit *LabelEdit
+}
+
+// A LabelSetDiff holds the changes needed for the labels of a Repository to
+// match a LabelSet.
+type LabelSetDiff struct {
+	Create []*github.Label
+	Update []LabelUpdate
+	Delete []string
+}
+
+// Service defines the Repository label operations
+type Service interface {
+	GetLabel(ctx context.Context, owner, repo, name string) (*github.Label, *github.Response, error)
+	ListLabels(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error)
+	CreateLabel(ctx context.Context, owner, repo string, label *github.Label) (*github.Label, *github.Response, error)
+	EditLabel(ctx context.Context, owner, repo, name string, edit *LabelEdit) (*github.Label, *github.Response, error)
+	DeleteLabel(ctx context.Context, owner, repo, name string) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+// labelPath escapes the name of the label, which go-github does not. Label
+// names may contain characters like / and ?.
+func labelPath(owner, repo, name string) string {
+	return fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, url.PathEscape(name))
+}
+
+func (s *service) GetLabel(ctx context.Context, owner, repo, name string) (*github.Label, *github.Response, error) {
+	req, err := s.client.NewRequest("GET", labelPath(owner, repo, name), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	l := &github.Label{}
+	res, err := s.client.Do(ctx, req, l)
+	if err != nil {
+		return nil, res, err
+	}
+	return l, res, nil
+}
+
+func (s *service) ListLabels(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return s.client.Issues.ListLabels(ctx, owner, repo, opts)
+}
+
+func (s *service) CreateLabel(ctx context.Context, owner, repo string, label *github.Label) (*github.Label, *github.Response, error) {
+	return s.client.Issues.CreateLabel(ctx, owner, repo, label)
+}
+
+func (s *service) EditLabel(ctx context.Context, owner, repo, name string, edit *LabelEdit) (*github.Label, *github.Response, error) {
+	req, err := s.client.NewRequest("PATCH", labelPath(owner, repo, name), edit)
+	if err != nil {
+		return nil, nil, err
+	}
+	l := &github.Label{}
+	res, err := s.client.Do(ctx, req, l)
+	if err != nil {
+		return nil, res, err
+	}
+	return l, res, nil
+}
+
+func (s *service) DeleteLabel(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	req, err := s.client.NewRequest("DELETE", labelPath(owner, repo, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListAllLabels returns all labels of a Repository.
+func ListAllLabels(ctx context.Context, s Service, owner, repo string) ([]*github.Label, error) {
+	var labels []*github.Label
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		l, res, err := s.ListLabels(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l...)
+		if res == nil || res.NextPage == 0 {
+			return labels, nil
+		}
+		opts.Page = res.NextPage
+	}
+}
+
+// isColor checks whether two color codes are the same. GitHub does not
+// preserve the case of color codes.
+func isColor(a, b string) bool {
+	return strings.EqualFold(strings.TrimPrefix(a, "#"), strings.TrimPrefix(b, "#"))
+}
+
+// isLabelUpToDate checks whether the supplied label has the supplied name,
+// color and description. A nil description is not compared.
+func isLabelUpToDate(name, color string, description *string, l *github.Label) bool {
+	if description != nil && *description != l.GetDescription() {
+		return false
+	}
+	return name == l.GetName() && isColor(color, l.GetColor())
+}
+
+// GenerateLabel produces github.Label object from LabelParameters object.
+func GenerateLabel(p v1alpha1.LabelParameters) *github.Label {
+	return &github.Label{
+		Name:        ghclient.StringPtr(p.Name),
+		Color:       ghclient.StringPtr(p.Color),
+		Description: p.Description,
+	}
+}
+
+// GenerateLabelEdit produces LabelEdit object from LabelParameters object.
+func GenerateLabelEdit(p v1alpha1.LabelParameters) *LabelEdit {
+	return &LabelEdit{
+		NewName:     p.Name,
+		Color:       p.Color,
+		Description: p.Description,
+	}
+}
+
+// IsUpToDate checks whether the label has the name, the color and the
+// description given in LabelParameters.
+func IsUpToDate(p v1alpha1.LabelParameters, l *github.Label) bool {
+	return isLabelUpToDate(p.Name, p.Color, p.Description, l)
+}
+
+// LateInitialize fills the empty fields of LabelParameters if the
+// corresponding fields are observed.
+func LateInitialize(p *v1alpha1.LabelParameters, l *github.Label) {
+	if p.Description == nil && l.Description != nil {
+		p.Description = ghclient.StringPtr(l.GetDescription())
+	}
+}
+
+// GenerateObservation produces LabelObservation object from github.Label
+// object.
+func GenerateObservation(l *github.Label) v1alpha1.LabelObservation {
+	return v1alpha1.LabelObservation{
+		ID:      l.GetID(),
+		NodeID:  l.GetNodeID(),
+		URL:     l.GetURL(),
+		Default: l.GetDefault(),
+	}
+}
+
+// DiffLabelSet returns the labels to create, edit and delete for the supplied
+// labels of a Repository to match LabelSetParameters. Labels are matched by
+// name regardless of case, as GitHub does, so only the first of the labels of
+// the set whose names differ only in case is taken into account.
+func DiffLabelSet(p v1alpha1.LabelSetParameters, labels []*github.Label) LabelSetDiff {
+	observed := make(map[string]*github.Label, len(labels))
+	for _, l := range labels {
+		observed[strings.ToLower(l.GetName())] = l
+	}
+
+	d := LabelSetDiff{}
+	desired := make(map[string]bool, len(p.Labels))
+	for _, sl := range p.Labels {
+		key := strings.ToLower(sl.Name)
+		if desired[key] {
+			continue
+		}
+		desired[key] = true
+		l, ok := observed[key]
+		switch {
+		case !ok:
+			d.Create = append(d.Create, &github.Label{
+				Name:        ghclient.StringPtr(sl.Name),
+				Color:       ghclient.StringPtr(sl.Color),
+				Description: sl.Description,
+			})
+		case !isLabelUpToDate(sl.Name, sl.Color, sl.Description, l):
+			d.Update = append(d.Update, LabelUpdate{
+				Name: l.GetName(),
+				Edit: &LabelEdit{NewName: sl.Name, Color: sl.Color, Description: sl.Description},
+			})
+		}
+	}
+
+	if ghclient.BoolValue(p.DeleteUnmanaged) {
+		for _, l := range labels {
+			if !desired[strings.ToLower(l.GetName())] {
+				d.Delete = append(d.Delete, l.GetName())
+			}
+		}
+	}
+	return d
+}
+
+// IsEmpty checks whether the LabelSetDiff holds no changes.
+func (d LabelSetDiff) IsEmpty() bool {
+	return len(d.Create) == 0 && len(d.Update) == 0 && len(d.Delete) == 0
+}
+
+// GenerateLabelSetObservation produces LabelSetObservation object from the
+// labels of a Repository.
+func GenerateLabelSetObservation(p v1alpha1.LabelSetParameters, labels []*github.Label) v1alpha1.LabelSetObservation {
+	desired := make(map[string]bool, len(p.Labels))
+	for _, sl := range p.Labels {
+		desired[strings.ToLower(sl.Name)] = true
+	}
+
+	o := v1alpha1.LabelSetObservation{}
+	for _, l := range labels {
+		if desired[strings.ToLower(l.GetName())] {
+			o.ManagedLabels++
+			continue
+		}
+		o.UnmanagedLabels = append(o.UnmanagedLabels, l.GetName())
+	}
+	sort.Strings(o.UnmanagedLabels)
+	return o
+}
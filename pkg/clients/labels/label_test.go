@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labels
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func label(name, color, description string) *github.Label {
+	return &github.Label{
+		Name:        github.String(name),
+		Color:       github.String(color),
+		Description: github.String(description),
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.LabelParameters
+		l      *github.Label
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Must be up to date if the colors only differ in case",
+			p:      v1alpha1.LabelParameters{Name: "bug", Color: "D73A4A", Description: github.String("Something isn't working")},
+			l:      label("bug", "d73a4a", "Something isn't working"),
+			want:   true,
+		},
+		"NoDescription": {
+			reason: "Must not compare the description if it is not set",
+			p:      v1alpha1.LabelParameters{Name: "bug", Color: "d73a4a"},
+			l:      label("bug", "d73a4a", "Something isn't working"),
+			want:   true,
+		},
+		"Renamed": {
+			reason: "Must not be up to date if the label has another name",
+			p:      v1alpha1.LabelParameters{Name: "kind: bug", Color: "d73a4a"},
+			l:      label("bug", "d73a4a", ""),
+			want:   false,
+		},
+		"Color": {
+			reason: "Must not be up to date if the label has another color",
+			p:      v1alpha1.LabelParameters{Name: "bug", Color: "ff0000"},
+			l:      label("bug", "d73a4a", ""),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.l)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffLabelSet(t *testing.T) {
+	observed := []*github.Label{
+		label("Bug", "d73a4a", "Something isn't working"),
+		label("enhancement", "a2eeef", "New feature or request"),
+		label("wontfix", "ffffff", "This will not be worked on"),
+	}
+	desired := []v1alpha1.LabelSetLabel{
+		{Name: "bug", Color: "d73a4a"},
+		{Name: "enhancement", Color: "A2EEEF", Description: github.String("New feature or request")},
+		{Name: "documentation", Color: "0075ca"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.LabelSetParameters
+		want   LabelSetDiff
+	}{
+		"KeepUnmanaged": {
+			reason: "Must create missing labels, rename labels whose name differs in case and keep unmanaged labels",
+			p:      v1alpha1.LabelSetParameters{Labels: desired},
+			want: LabelSetDiff{
+				Create: []*github.Label{{Name: github.String("documentation"), Color: github.String("0075ca")}},
+				Update: []LabelUpdate{{Name: "Bug", Edit: &LabelEdit{NewName: "bug", Color: "d73a4a"}}},
+			},
+		},
+		"DeleteUnmanaged": {
+			reason: "Must delete the labels that are not in the set if DeleteUnmanaged is true",
+			p:      v1alpha1.LabelSetParameters{Labels: desired, DeleteUnmanaged: github.Bool(true)},
+			want: LabelSetDiff{
+				Create: []*github.Label{{Name: github.String("documentation"), Color: github.String("0075ca")}},
+				Update: []LabelUpdate{{Name: "Bug", Edit: &LabelEdit{NewName: "bug", Color: "d73a4a"}}},
+				Delete: []string{"wontfix"},
+			},
+		},
+		"UpToDate": {
+			reason: "Must return no changes if the Repository has exactly the labels of the set",
+			p: v1alpha1.LabelSetParameters{
+				Labels: []v1alpha1.LabelSetLabel{
+					{Name: "Bug", Color: "d73a4a"},
+					{Name: "enhancement", Color: "a2eeef"},
+					{Name: "wontfix", Color: "ffffff"},
+				},
+				DeleteUnmanaged: github.Bool(true),
+			},
+			want: LabelSetDiff{},
+		},
+		"DuplicateNames": {
+			reason: "Must only take the first of the labels whose names differ only in case into account",
+			p: v1alpha1.LabelSetParameters{
+				Labels: []v1alpha1.LabelSetLabel{
+					{Name: "Bug", Color: "d73a4a"},
+					{Name: "bug", Color: "ffffff"},
+				},
+			},
+			want: LabelSetDiff{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffLabelSet(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDiffLabelSet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLabelSetObservation(t *testing.T) {
+	p := v1alpha1.LabelSetParameters{Labels: []v1alpha1.LabelSetLabel{{Name: "bug"}, {Name: "enhancement"}}}
+	observed := []*github.Label{label("wontfix", "", ""), label("Bug", "", ""), label("duplicate", "", "")}
+	want := v1alpha1.LabelSetObservation{ManagedLabels: 1, UnmanagedLabels: []string{"duplicate", "wontfix"}}
+
+	got := GenerateLabelSetObservation(p, observed)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateLabelSetObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestServiceEditLabel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/repos/crossplane/sample/labels/needs: triage" {
+			t.Errorf("EditLabel(...): unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]interface{}{"new_name": "triage", "color": "fbca04"}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("EditLabel(...): -want body, +got body:\n%s", diff)
+		}
+		_, _ = w.Write([]byte(`{"name":"triage","color":"fbca04"}`))
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	s := &service{client: gh}
+
+	l, _, err := s.EditLabel(context.Background(), "crossplane", "sample", "needs: triage", &LabelEdit{NewName: "triage", Color: "fbca04"})
+	if err != nil {
+		t.Fatalf("EditLabel(...): %s", err)
+	}
+	if diff := cmp.Diff("triage", l.GetName()); diff != "" {
+		t.Errorf("EditLabel(...): -want name, +got name:\n%s", diff)
+	}
+}
+
+func TestServiceGetLabel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.EscapedPath() != "/repos/crossplane/sample/labels/kind%2Fbug%3F" {
+			t.Errorf("GetLabel(...): unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+		_, _ = w.Write([]byte(`{"name":"kind/bug?","color":"d73a4a"}`))
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	s := &service{client: gh}
+
+	l, _, err := s.GetLabel(context.Background(), "crossplane", "sample", "kind/bug?")
+	if err != nil {
+		t.Fatalf("GetLabel(...): %s", err)
+	}
+	if diff := cmp.Diff("kind/bug?", l.GetName()); diff != "" {
+		t.Errorf("GetLabel(...): -want name, +got name:\n%s", diff)
+	}
+}
+
+func TestServiceDeleteLabel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.EscapedPath() != "/repos/crossplane/sample/labels/kind%2Fbug%3F" {
+			t.Errorf("DeleteLabel(...): unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	s := &service{client: gh}
+
+	if _, err := s.DeleteLabel(context.Background(), "crossplane", "sample", "kind/bug?"); err != nil {
+		t.Fatalf("DeleteLabel(...): %s", err)
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+// RepositoryPermissions are the permissions of the built-in repository roles,
+// from the most to the least privileged, in the form they are granted in.
+var RepositoryPermissions = []string{"admin", "maintain", "push", "triage", "pull"}
+
+// GitHub names the pull and push permissions differently when it returns the
+// role of a user or team on a repository.
+var repositoryRoleNames = map[string]string{
+	"pull": "read",
+	"push": "write",
+}
+
+// RepositoryPermission returns the permission the supplied repository role is
+// granted with. Custom repository roles are returned unchanged.
+func RepositoryPermission(role string) string {
+	for p, r := range repositoryRoleNames {
+		if r == role {
+			return p
+		}
+	}
+	return role
+}
+
+// RepositoryRoleName returns the name GitHub uses for the supplied repository
+// permission when it returns it. Custom repository roles are returned
+// unchanged.
+func RepositoryRoleName(permission string) string {
+	if r, ok := repositoryRoleNames[permission]; ok {
+		return r
+	}
+	return permission
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/v1beta1"
+)
+
+const (
+	errUpdateRateLimitCondition = "cannot update rate limit condition of ProviderConfig"
+
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRateResource  = "X-RateLimit-Resource"
+	headerRetryAfter    = "Retry-After"
+
+	// The rate limit of the REST API endpoints used by the controllers. The
+	// search and GraphQL APIs have separate limits that are not tracked.
+	rateLimitResourceCore = "core"
+
+	// GitHub asks clients that hit a secondary rate limit without being told
+	// when to retry to wait at least a minute.
+	defaultRetryAfter = time.Minute
+
+	// GitHub identifies secondary rate limit errors by this documentation URL.
+	secondaryRateLimitDocs = "https://docs.github.com/rest/overview/resources-in-the-rest-api#abuse-rate-limits"
+)
+
+// TypeRateLimited indicates whether the requests made with a ProviderConfig are
+// paused because a GitHub API rate limit was exceeded.
+const TypeRateLimited xpv1.ConditionType = "RateLimited"
+
+// Reasons a ProviderConfig is or is not rate limited.
+const (
+	ReasonRateLimitExceeded  xpv1.ConditionReason = "RateLimitExceeded"
+	ReasonRateLimitAvailable xpv1.ConditionReason = "RateLimitAvailable"
+)
+
+// RateLimited returns a condition that indicates requests made with a
+// ProviderConfig are paused until the supplied time.
+func RateLimited(until time.Time) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRateLimited,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRateLimitExceeded,
+		Message:            fmt.Sprintf("GitHub API rate limit exceeded, requests are paused until %s", until.UTC().Format(time.RFC3339)),
+	}
+}
+
+// RateLimitAvailable returns a condition that indicates requests may be made
+// with a ProviderConfig.
+func RateLimitAvailable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRateLimited,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRateLimitAvailable,
+	}
+}
+
+// A RateLimit is the GitHub API rate limit state of a ProviderConfig.
+type RateLimit struct {
+	// Limit is the number of requests allowed per hour.
+	Limit int
+
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+
+	// Reset is when the current window ends.
+	Reset time.Time
+
+	// RetryAfter is when requests may be made again after a secondary rate
+	// limit was hit.
+	RetryAfter time.Time
+}
+
+// PausedUntil returns the time until which no requests may be made, and
+// whether requests are paused at the supplied time.
+func (r RateLimit) PausedUntil(now time.Time) (time.Time, bool) {
+	until := time.Time{}
+	if r.Remaining == 0 && r.Reset.After(now) {
+		until = r.Reset
+	}
+	if r.RetryAfter.After(now) && r.RetryAfter.After(until) {
+		until = r.RetryAfter
+	}
+	return until, !until.IsZero()
+}
+
+// DefaultRateLimitTracker is the RateLimitTracker shared by all controllers.
+var DefaultRateLimitTracker = NewRateLimitTracker()
+
+// A RateLimitTracker records the GitHub API rate limit state of each
+// ProviderConfig from the responses to the requests made with it.
+type RateLimitTracker struct {
+	mu     sync.RWMutex
+	limits map[string]RateLimit
+
+	now func() time.Time
+}
+
+// NewRateLimitTracker returns a RateLimitTracker that tracks no
+// ProviderConfigs yet.
+func NewRateLimitTracker() *RateLimitTracker {
+	return &RateLimitTracker{limits: map[string]RateLimit{}, now: time.Now}
+}
+
+// Get the rate limit state of the named ProviderConfig.
+func (t *RateLimitTracker) Get(name string) RateLimit {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.limits[name]
+}
+
+// Observe updates the rate limit state of the named ProviderConfig from the
+// headers of the supplied response.
+func (t *RateLimitTracker) Observe(name string, res *http.Response) {
+	if r := res.Header.Get(headerRateResource); r != "" && r != rateLimitResourceCore {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rl := t.limits[name]
+	if v, err := strconv.Atoi(res.Header.Get(headerRateLimit)); err == nil {
+		rl.Limit = v
+	}
+	if v, err := strconv.Atoi(res.Header.Get(headerRateRemaining)); err == nil {
+		rl.Remaining = v
+	}
+	if v, err := strconv.ParseInt(res.Header.Get(headerRateReset), 10, 64); err == nil {
+		rl.Reset = time.Unix(v, 0)
+	}
+	if res.StatusCode == http.StatusForbidden || res.StatusCode == http.StatusTooManyRequests {
+		if v, err := strconv.Atoi(res.Header.Get(headerRetryAfter)); err == nil {
+			rl.RetryAfter = t.now().Add(time.Duration(v) * time.Second)
+		} else if res.StatusCode == http.StatusTooManyRequests {
+			rl.RetryAfter = t.now().Add(defaultRetryAfter)
+		}
+	}
+	t.limits[name] = rl
+}
+
+// Transport returns an http.RoundTripper that records the rate limit state of
+// the named ProviderConfig from every response. While the ProviderConfig is
+// rate limited no requests are sent to GitHub. Instead a rate limit error
+// response is returned, like go-github does for the primary rate limit.
+func (t *RateLimitTracker) Transport(name string, base http.RoundTripper) http.RoundTripper {
+	return &rateLimitTransport{name: name, tracker: t, base: base}
+}
+
+type rateLimitTransport struct {
+	name    string
+	tracker *RateLimitTracker
+	base    http.RoundTripper
+}
+
+// RoundTrip sends the supplied request unless the ProviderConfig is rate
+// limited.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rl := t.tracker.Get(t.name)
+	now := t.tracker.now()
+	if until, paused := rl.PausedUntil(now); paused {
+		return rateLimitedResponse(req, rl, until, now), nil
+	}
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	t.tracker.Observe(t.name, res)
+	return res, nil
+}
+
+// rateLimitedResponse fakes the response GitHub sends when the supplied rate
+// limit is exceeded.
+func rateLimitedResponse(req *http.Request, rl RateLimit, until, now time.Time) *http.Response {
+	h := http.Header{}
+	h.Set(headerRateLimit, strconv.Itoa(rl.Limit))
+	h.Set(headerRateRemaining, strconv.Itoa(rl.Remaining))
+	h.Set(headerRateReset, strconv.FormatInt(rl.Reset.Unix(), 10))
+
+	body := map[string]string{
+		"message": fmt.Sprintf("API rate limit exceeded, not making remote request until %s", until.UTC().Format(time.RFC3339)),
+	}
+	if until.Equal(rl.RetryAfter) {
+		h.Set(headerRetryAfter, strconv.Itoa(int(until.Sub(now).Seconds())+1))
+		body["documentation_url"] = secondaryRateLimitDocs
+	}
+	b, _ := json.Marshal(body) // nolint:errcheck
+
+	return &http.Response{
+		Status:     http.StatusText(http.StatusForbidden),
+		StatusCode: http.StatusForbidden,
+		Request:    req,
+		Header:     h,
+		Body:       ioutil.NopCloser(bytes.NewReader(b)),
+	}
+}
+
+// updateRateLimitCondition sets the RateLimited condition of the supplied
+// ProviderConfig to reflect its tracked rate limit state. The status is only
+// written when the condition changed.
+func updateRateLimitCondition(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, t *RateLimitTracker) error {
+	cond := RateLimitAvailable()
+	if until, paused := t.Get(pc.GetName()).PausedUntil(t.now()); paused {
+		cond = RateLimited(until)
+	}
+	if pc.GetCondition(TypeRateLimited).Equal(cond) {
+		return nil
+	}
+	pc.SetConditions(cond)
+	err := resource.Ignore(kerrors.IsConflict, c.Status().Update(ctx, pc))
+	return errors.Wrap(err, errUpdateRateLimitCondition)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+)
+
+type roundTripperFn func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestRateLimitTrackerObserve(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	reset := now.Add(30 * time.Minute)
+
+	type want struct {
+		paused bool
+		until  time.Time
+	}
+	cases := map[string]struct {
+		reason string
+		status int
+		header map[string]string
+		want   want
+	}{
+		"WithinLimit": {
+			reason: "Must not pause requests while there are requests remaining",
+			status: http.StatusOK,
+			header: map[string]string{
+				headerRateLimit:     "5000",
+				headerRateRemaining: "4999",
+				headerRateReset:     strconv.FormatInt(reset.Unix(), 10),
+			},
+			want: want{},
+		},
+		"PrimaryLimitExceeded": {
+			reason: "Must pause requests until reset when no requests are remaining",
+			status: http.StatusForbidden,
+			header: map[string]string{
+				headerRateLimit:     "5000",
+				headerRateRemaining: "0",
+				headerRateReset:     strconv.FormatInt(reset.Unix(), 10),
+			},
+			want: want{paused: true, until: reset},
+		},
+		"OtherResourceExceeded": {
+			reason: "Must ignore the rate limits of other API resources",
+			status: http.StatusForbidden,
+			header: map[string]string{
+				headerRateResource:  "search",
+				headerRateRemaining: "0",
+				headerRateReset:     strconv.FormatInt(reset.Unix(), 10),
+			},
+			want: want{},
+		},
+		"SecondaryLimitRetryAfter": {
+			reason: "Must pause requests for as long as GitHub asks when a secondary limit is hit",
+			status: http.StatusForbidden,
+			header: map[string]string{
+				headerRateRemaining: "4000",
+				headerRetryAfter:    "120",
+			},
+			want: want{paused: true, until: now.Add(2 * time.Minute)},
+		},
+		"SecondaryLimitTooManyRequests": {
+			reason: "Must pause requests for a minute when a secondary limit is hit without Retry-After",
+			status: http.StatusTooManyRequests,
+			header: map[string]string{
+				headerRateRemaining: "4000",
+			},
+			want: want{paused: true, until: now.Add(defaultRetryAfter)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tr := NewRateLimitTracker()
+			tr.now = func() time.Time { return now }
+
+			res := &http.Response{StatusCode: tc.status, Header: http.Header{}}
+			for k, v := range tc.header {
+				res.Header.Set(k, v)
+			}
+			tr.Observe("default", res)
+
+			until, paused := tr.Get("default").PausedUntil(now)
+			if diff := cmp.Diff(tc.want, want{paused: paused, until: until}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	now := time.Now()
+	reset := now.Add(30 * time.Minute)
+
+	sent := 0
+	base := roundTripperFn(func(req *http.Request) (*http.Response, error) {
+		sent++
+		h := http.Header{}
+		h.Set(headerRateLimit, "5000")
+		h.Set(headerRateRemaining, "0")
+		h.Set(headerRateReset, strconv.FormatInt(reset.Unix(), 10))
+		return &http.Response{StatusCode: http.StatusOK, Header: h, Body: http.NoBody, Request: req}, nil
+	})
+
+	tr := NewRateLimitTracker()
+	hc := &http.Client{Transport: tr.Transport("default", base)}
+
+	// The first request uses up the rate limit. The second must not be sent,
+	// even though it is made with a client that has not seen the rate limit.
+	if _, _, err := github.NewClient(hc).Repositories.Get(context.Background(), "crossplane", "provider-github"); err != nil {
+		t.Fatalf("Get(...): %s", err)
+	}
+	_, res, err := github.NewClient(hc).Organizations.Get(context.Background(), "crossplane")
+	if _, ok := err.(*github.RateLimitError); !ok {
+		t.Errorf("Get(...): want *github.RateLimitError, got %T: %v", err, err)
+	}
+	if res == nil || res.StatusCode != http.StatusForbidden {
+		t.Errorf("Get(...): want a %d response, got %v", http.StatusForbidden, res)
+	}
+	if diff := cmp.Diff(1, sent); diff != "" {
+		t.Errorf("RoundTrip(...): -want requests sent, +got requests sent:\n%s", diff)
+	}
+}
@@ -43,11 +43,14 @@ type Service interface {
 }
 
 // NewService creates a new Service based on the *github.Client
-// returned by the NewClient SDK method.
-func NewService(token string) *Service {
-	c := ghclient.NewClient(token)
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
 	r := Service(c.Repositories)
-	return &r
+	return &r, nil
 }
 
 // IsUpToDate checks whether Repository is configured with given RepositoryParameters.
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryfiles
+
+import (
+	"context"
+	"crypto/sha1" // nolint:gosec
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// AnnotationKeyBlobSHA is the annotation that holds the SHA of the blob of
+// the file last committed by Crossplane. A file whose SHA differs from it was
+// changed by someone else.
+const AnnotationKeyBlobSHA = "github.crossplane.io/blob-sha"
+
+const errNoContent = "neither a content nor a ConfigMap holding it is given"
+
+// Service defines the Repository contents operations
+type Service interface {
+	GetContents(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error)
+	CreateFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	UpdateFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	DeleteFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(c.Repositories)
+	return &s, nil
+}
+
+// GetContent returns the content of a file, which is either given inline or
+// by the key of a ConfigMap.
+func GetContent(ctx context.Context, c client.Reader, p v1alpha1.RepositoryFileParameters) (string, error) {
+	switch {
+	case p.Content != nil:
+		return *p.Content, nil
+	case p.ContentConfigMapRef != nil:
+		ref := p.ContentConfigMapRef
+		return ghclient.GetConfigMapValue(ctx, c, ref.Namespace, ref.Name, ref.Key)
+	default:
+		return "", errors.New(errNoContent)
+	}
+}
+
+// BlobSHA returns the SHA git identifies a blob with the supplied content by.
+func BlobSHA(content string) string {
+	h := sha1.New() // nolint:gosec
+	_, _ = fmt.Fprintf(h, "blob %d\x00%s", len(content), content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// GetOptions produces RepositoryContentGetOptions object from
+// RepositoryFileParameters object.
+func GetOptions(p v1alpha1.RepositoryFileParameters) *github.RepositoryContentGetOptions {
+	return &github.RepositoryContentGetOptions{Ref: ghclient.StringValue(p.Branch)}
+}
+
+// GenerateFileOptions produces RepositoryContentFileOptions object from
+// RepositoryFileParameters object. The action names the commit if no commit
+// message is given.
+func GenerateFileOptions(p v1alpha1.RepositoryFileParameters, action string) *github.RepositoryContentFileOptions {
+	opts := &github.RepositoryContentFileOptions{
+		Message: p.CommitMessage,
+		Branch:  p.Branch,
+	}
+	if opts.Message == nil {
+		opts.Message = ghclient.StringPtr(fmt.Sprintf("%s %s", action, p.Path))
+	}
+	if p.CommitAuthor != nil {
+		opts.Author = &github.CommitAuthor{
+			Name:  ghclient.StringPtr(p.CommitAuthor.Name),
+			Email: ghclient.StringPtr(p.CommitAuthor.Email),
+		}
+	}
+	return opts
+}
+
+// IsModified checks whether the supplied file changed after it was last
+// committed by Crossplane, according to the AnnotationKeyBlobSHA annotation
+// of the supplied object.
+func IsModified(o metav1.Object, c *github.RepositoryContent) bool {
+	return o.GetAnnotations()[AnnotationKeyBlobSHA] != c.GetSHA()
+}
+
+// SetBlobSHA records the supplied SHA in the AnnotationKeyBlobSHA annotation
+// of the supplied object.
+func SetBlobSHA(o metav1.Object, sha string) {
+	meta.AddAnnotations(o, map[string]string{AnnotationKeyBlobSHA: sha})
+}
+
+// IsUpToDate checks whether the file has the supplied content. A modified
+// file is kept as is if changes are not overwritten.
+func IsUpToDate(p v1alpha1.RepositoryFileParameters, content string, c *github.RepositoryContent, modified bool) bool {
+	if BlobSHA(content) == c.GetSHA() {
+		return true
+	}
+	return modified && !OverwritesChanges(p)
+}
+
+// OverwritesChanges checks whether changes made to the file after it was last
+// committed by Crossplane are overwritten, or deleted with the file.
+func OverwritesChanges(p v1alpha1.RepositoryFileParameters) bool {
+	return p.OverwriteChanges == nil || *p.OverwriteChanges
+}
+
+// GenerateObservation produces RepositoryFileObservation object from
+// github.RepositoryContent object.
+func GenerateObservation(c *github.RepositoryContent, modified bool) v1alpha1.RepositoryFileObservation {
+	return v1alpha1.RepositoryFileObservation{
+		SHA:      c.GetSHA(),
+		Size:     c.GetSize(),
+		HTMLURL:  c.GetHTMLURL(),
+		Modified: modified,
+	}
+}
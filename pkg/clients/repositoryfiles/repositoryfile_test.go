@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryfiles
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func TestBlobSHA(t *testing.T) {
+	// The SHA git hash-object prints for a file holding "hello world\n".
+	want := "3b18e512dba79e4c8300dd08aeb37f8e728b8dad"
+	if diff := cmp.Diff(want, BlobSHA("hello world\n")); diff != "" {
+		t.Errorf("BlobSHA(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	content := "* @crossplane/platform\n"
+	changed := &github.RepositoryContent{SHA: github.String(BlobSHA("* @octocat\n"))}
+
+	cases := map[string]struct {
+		reason   string
+		p        v1alpha1.RepositoryFileParameters
+		c        *github.RepositoryContent
+		modified bool
+		want     bool
+	}{
+		"SameContent": {
+			reason: "Must be up to date if the file has the content",
+			c:      &github.RepositoryContent{SHA: github.String(BlobSHA(content))},
+			want:   true,
+		},
+		"ContentChanged": {
+			reason: "Must not be up to date if the content of the file was not modified but is different",
+			c:      changed,
+			want:   false,
+		},
+		"Overwrite": {
+			reason:   "Must not be up to date if the file was modified and changes are overwritten by default",
+			c:        changed,
+			modified: true,
+			want:     false,
+		},
+		"KeepChanges": {
+			reason:   "Must be up to date if the file was modified and changes are not overwritten",
+			p:        v1alpha1.RepositoryFileParameters{OverwriteChanges: github.Bool(false)},
+			c:        changed,
+			modified: true,
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, content, tc.c, tc.modified)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateFileOptions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.RepositoryFileParameters
+		want   *github.RepositoryContentFileOptions
+	}{
+		"DefaultMessage": {
+			reason: "Must name the commit after the action and the path if no message is given",
+			p:      v1alpha1.RepositoryFileParameters{Path: ".github/CODEOWNERS"},
+			want:   &github.RepositoryContentFileOptions{Message: github.String("Create .github/CODEOWNERS")},
+		},
+		"Full": {
+			reason: "Must use the commit message, the branch and the author given",
+			p: v1alpha1.RepositoryFileParameters{
+				Path:          ".github/CODEOWNERS",
+				Branch:        github.String("develop"),
+				CommitMessage: github.String("Add CODEOWNERS"),
+				CommitAuthor:  &v1alpha1.CommitAuthor{Name: "Crossplane", Email: "crossplane@example.org"},
+			},
+			want: &github.RepositoryContentFileOptions{
+				Message: github.String("Add CODEOWNERS"),
+				Branch:  github.String("develop"),
+				Author:  &github.CommitAuthor{Name: github.String("Crossplane"), Email: github.String("crossplane@example.org")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateFileOptions(tc.p, "Create")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateFileOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
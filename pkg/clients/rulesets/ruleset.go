@@ -0,0 +1,526 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/go-github/v33/github"
+
+	organizations "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// Types of rules that have parameters.
+const (
+	ruleUpdate                   = "update"
+	ruleRequiredDeployments      = "required_deployments"
+	rulePullRequest              = "pull_request"
+	ruleRequiredStatusChecks     = "required_status_checks"
+	ruleCommitMessagePattern     = "commit_message_pattern"
+	ruleCommitAuthorEmailPattern = "commit_author_email_pattern"
+	ruleCommitterEmailPattern    = "committer_email_pattern"
+	ruleBranchNamePattern        = "branch_name_pattern"
+	ruleTagNamePattern           = "tag_name_pattern"
+)
+
+const bypassModeAlways = "always"
+
+// A Ruleset is a GitHub ruleset. Rulesets are not supported by go-github yet.
+type Ruleset struct {
+	ID           *int64         `json:"id,omitempty"`
+	NodeID       *string        `json:"node_id,omitempty"`
+	Name         string         `json:"name"`
+	Target       *string        `json:"target,omitempty"`
+	SourceType   *string        `json:"source_type,omitempty"`
+	Source       *string        `json:"source,omitempty"`
+	Enforcement  string         `json:"enforcement"`
+	BypassActors []*BypassActor `json:"bypass_actors"`
+	Conditions   *Conditions    `json:"conditions,omitempty"`
+	Rules        []*Rule        `json:"rules"`
+}
+
+// A BypassActor is an actor that can bypass the rules of a Ruleset.
+type BypassActor struct {
+	ActorID    *int64  `json:"actor_id,omitempty"`
+	ActorType  string  `json:"actor_type"`
+	BypassMode *string `json:"bypass_mode,omitempty"`
+}
+
+// Conditions select the repositories and refs a Ruleset applies to.
+// Repositories can only be selected by rulesets of organizations.
+type Conditions struct {
+	RefName        *RefNameCondition        `json:"ref_name,omitempty"`
+	RepositoryName *RepositoryNameCondition `json:"repository_name,omitempty"`
+}
+
+// A RefNameCondition selects refs by name.
+type RefNameCondition struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// A RepositoryNameCondition selects repositories by name.
+type RepositoryNameCondition struct {
+	Include   []string `json:"include"`
+	Exclude   []string `json:"exclude"`
+	Protected bool     `json:"protected"`
+}
+
+// A Rule is a rule enforced by a Ruleset.
+type Rule struct {
+	Type       string          `json:"type"`
+	Parameters *RuleParameters `json:"parameters,omitempty"`
+}
+
+// RuleParameters are the parameters of all types of rules. Only the
+// parameters that belong to the type of a rule are set.
+type RuleParameters struct {
+	UpdateAllowsFetchAndMerge        *bool          `json:"update_allows_fetch_and_merge,omitempty"`
+	RequiredDeploymentEnvironments   *[]string      `json:"required_deployment_environments,omitempty"`
+	DismissStaleReviewsOnPush        *bool          `json:"dismiss_stale_reviews_on_push,omitempty"`
+	RequireCodeOwnerReview           *bool          `json:"require_code_owner_review,omitempty"`
+	RequireLastPushApproval          *bool          `json:"require_last_push_approval,omitempty"`
+	RequiredApprovingReviewCount     *int           `json:"required_approving_review_count,omitempty"`
+	RequiredReviewThreadResolution   *bool          `json:"required_review_thread_resolution,omitempty"`
+	RequiredStatusChecks             *[]StatusCheck `json:"required_status_checks,omitempty"`
+	StrictRequiredStatusChecksPolicy *bool          `json:"strict_required_status_checks_policy,omitempty"`
+	Name                             *string        `json:"name,omitempty"`
+	Negate                           *bool          `json:"negate,omitempty"`
+	Operator                         *string        `json:"operator,omitempty"`
+	Pattern                          *string        `json:"pattern,omitempty"`
+}
+
+// A StatusCheck is a status check required by a Rule.
+type StatusCheck struct {
+	Context       string `json:"context"`
+	IntegrationID *int64 `json:"integration_id,omitempty"`
+}
+
+// Service defines the Ruleset operations
+type Service interface {
+	GetRepositoryRuleset(ctx context.Context, owner, repo string, id int64) (*Ruleset, *github.Response, error)
+	CreateRepositoryRuleset(ctx context.Context, owner, repo string, rs *Ruleset) (*Ruleset, *github.Response, error)
+	UpdateRepositoryRuleset(ctx context.Context, owner, repo string, id int64, rs *Ruleset) (*Ruleset, *github.Response, error)
+	DeleteRepositoryRuleset(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
+	GetOrganizationRuleset(ctx context.Context, org string, id int64) (*Ruleset, *github.Response, error)
+	CreateOrganizationRuleset(ctx context.Context, org string, rs *Ruleset) (*Ruleset, *github.Response, error)
+	UpdateOrganizationRuleset(ctx context.Context, org string, id int64, rs *Ruleset) (*Ruleset, *github.Response, error)
+	DeleteOrganizationRuleset(ctx context.Context, org string, id int64) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func (s *service) GetRepositoryRuleset(ctx context.Context, owner, repo string, id int64) (*Ruleset, *github.Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, id), nil)
+}
+
+func (s *service) CreateRepositoryRuleset(ctx context.Context, owner, repo string, rs *Ruleset) (*Ruleset, *github.Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("repos/%v/%v/rulesets", owner, repo), rs)
+}
+
+func (s *service) UpdateRepositoryRuleset(ctx context.Context, owner, repo string, id int64, rs *Ruleset) (*Ruleset, *github.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, id), rs)
+}
+
+func (s *service) DeleteRepositoryRuleset(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, id))
+}
+
+func (s *service) GetOrganizationRuleset(ctx context.Context, org string, id int64) (*Ruleset, *github.Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("orgs/%v/rulesets/%v", org, id), nil)
+}
+
+func (s *service) CreateOrganizationRuleset(ctx context.Context, org string, rs *Ruleset) (*Ruleset, *github.Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("orgs/%v/rulesets", org), rs)
+}
+
+func (s *service) UpdateOrganizationRuleset(ctx context.Context, org string, id int64, rs *Ruleset) (*Ruleset, *github.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("orgs/%v/rulesets/%v", org, id), rs)
+}
+
+func (s *service) DeleteOrganizationRuleset(ctx context.Context, org string, id int64) (*github.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("orgs/%v/rulesets/%v", org, id))
+}
+
+func (s *service) delete(ctx context.Context, u string) (*github.Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *service) do(ctx context.Context, method, u string, body interface{}) (*Ruleset, *github.Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	rs := &Ruleset{}
+	res, err := s.client.Do(ctx, req, rs)
+	if err != nil {
+		return nil, res, err
+	}
+	return rs, res, nil
+}
+
+// GenerateRepositoryRuleset produces a Ruleset from
+// RepositoryRulesetParameters.
+func GenerateRepositoryRuleset(p v1alpha1.RepositoryRulesetParameters) *Ruleset {
+	rs := &Ruleset{
+		Name:         p.Name,
+		Target:       p.Target,
+		Enforcement:  p.Enforcement,
+		BypassActors: generateBypassActors(p.BypassActors),
+		Rules:        generateRules(p.Rules),
+	}
+	if p.Conditions != nil && p.Conditions.RefName != nil {
+		rs.Conditions = &Conditions{RefName: generateRefNameCondition(p.Conditions.RefName)}
+	}
+	return rs
+}
+
+// IsRepositoryRulesetUpToDate checks whether Ruleset is configured with given
+// RepositoryRulesetParameters.
+func IsRepositoryRulesetUpToDate(p v1alpha1.RepositoryRulesetParameters, rs *Ruleset) bool {
+	// Round-tripping the parameters fills in the values GitHub defaults to.
+	desired := generateRepositoryRulesetParameters(GenerateRepositoryRuleset(p))
+	observed := generateRepositoryRulesetParameters(rs)
+	if p.Target == nil {
+		desired.Target = observed.Target
+	}
+	return cmp.Equal(desired, observed, equateRulesets()...)
+}
+
+// LateInitializeRepositoryRuleset fills the empty fields of
+// RepositoryRulesetParameters if the corresponding fields are given in
+// Ruleset.
+func LateInitializeRepositoryRuleset(p *v1alpha1.RepositoryRulesetParameters, rs *Ruleset) {
+	o := generateRepositoryRulesetParameters(rs)
+	if p.Target == nil {
+		p.Target = o.Target
+	}
+	if p.BypassActors == nil {
+		p.BypassActors = o.BypassActors
+	}
+	if p.Conditions == nil {
+		p.Conditions = o.Conditions
+	}
+	if p.Rules == nil {
+		p.Rules = o.Rules
+	}
+}
+
+// GenerateRepositoryRulesetObservation produces RepositoryRulesetObservation
+// object from Ruleset object.
+func GenerateRepositoryRulesetObservation(rs *Ruleset) v1alpha1.RepositoryRulesetObservation {
+	return v1alpha1.RepositoryRulesetObservation{
+		ID:         ghclient.Int64Value(rs.ID),
+		NodeID:     ghclient.StringValue(rs.NodeID),
+		SourceType: ghclient.StringValue(rs.SourceType),
+		Source:     ghclient.StringValue(rs.Source),
+	}
+}
+
+// GenerateOrganizationRuleset produces a Ruleset from
+// OrganizationRulesetParameters.
+func GenerateOrganizationRuleset(p organizations.OrganizationRulesetParameters) *Ruleset {
+	rs := &Ruleset{
+		Name:         p.Name,
+		Target:       p.Target,
+		Enforcement:  p.Enforcement,
+		BypassActors: generateBypassActors(p.BypassActors),
+		Conditions: &Conditions{
+			RepositoryName: &RepositoryNameCondition{
+				Include:   ghclient.StringSliceValue(p.Conditions.RepositoryName.Include),
+				Exclude:   ghclient.StringSliceValue(p.Conditions.RepositoryName.Exclude),
+				Protected: p.Conditions.RepositoryName.Protected,
+			},
+		},
+		Rules: generateRules(p.Rules),
+	}
+	if p.Conditions.RefName != nil {
+		rs.Conditions.RefName = generateRefNameCondition(p.Conditions.RefName)
+	}
+	return rs
+}
+
+// IsOrganizationRulesetUpToDate checks whether Ruleset is configured with
+// given OrganizationRulesetParameters.
+func IsOrganizationRulesetUpToDate(p organizations.OrganizationRulesetParameters, rs *Ruleset) bool {
+	// Round-tripping the parameters fills in the values GitHub defaults to.
+	desired := generateOrganizationRulesetParameters(GenerateOrganizationRuleset(p))
+	observed := generateOrganizationRulesetParameters(rs)
+	if p.Target == nil {
+		desired.Target = observed.Target
+	}
+	return cmp.Equal(desired, observed, equateRulesets()...)
+}
+
+// LateInitializeOrganizationRuleset fills the empty fields of
+// OrganizationRulesetParameters if the corresponding fields are given in
+// Ruleset.
+func LateInitializeOrganizationRuleset(p *organizations.OrganizationRulesetParameters, rs *Ruleset) {
+	o := generateOrganizationRulesetParameters(rs)
+	if p.Target == nil {
+		p.Target = o.Target
+	}
+	if p.BypassActors == nil {
+		p.BypassActors = o.BypassActors
+	}
+	if p.Conditions.RefName == nil {
+		p.Conditions.RefName = o.Conditions.RefName
+	}
+	if p.Rules == nil {
+		p.Rules = o.Rules
+	}
+}
+
+// GenerateOrganizationRulesetObservation produces
+// OrganizationRulesetObservation object from Ruleset object.
+func GenerateOrganizationRulesetObservation(rs *Ruleset) organizations.OrganizationRulesetObservation {
+	return organizations.OrganizationRulesetObservation{
+		ID:         ghclient.Int64Value(rs.ID),
+		NodeID:     ghclient.StringValue(rs.NodeID),
+		SourceType: ghclient.StringValue(rs.SourceType),
+		Source:     ghclient.StringValue(rs.Source),
+	}
+}
+
+func generateOrganizationRulesetParameters(rs *Ruleset) organizations.OrganizationRulesetParameters {
+	p := organizations.OrganizationRulesetParameters{
+		Name:         rs.Name,
+		Target:       rs.Target,
+		Enforcement:  rs.Enforcement,
+		BypassActors: generateRulesetBypassActors(rs.BypassActors),
+		Rules:        generateRulesetRules(rs.Rules),
+	}
+	if rs.Conditions != nil {
+		p.Conditions.RefName = generateRulesetRefNameCondition(rs.Conditions.RefName)
+		if rn := rs.Conditions.RepositoryName; rn != nil {
+			p.Conditions.RepositoryName = organizations.RulesetRepositoryNameCondition{
+				Include:   rn.Include,
+				Exclude:   rn.Exclude,
+				Protected: rn.Protected,
+			}
+		}
+	}
+	return p
+}
+
+func generateRepositoryRulesetParameters(rs *Ruleset) v1alpha1.RepositoryRulesetParameters {
+	p := v1alpha1.RepositoryRulesetParameters{
+		Name:         rs.Name,
+		Target:       rs.Target,
+		Enforcement:  rs.Enforcement,
+		BypassActors: generateRulesetBypassActors(rs.BypassActors),
+		Rules:        generateRulesetRules(rs.Rules),
+	}
+	if rs.Conditions != nil {
+		if rn := generateRulesetRefNameCondition(rs.Conditions.RefName); rn != nil {
+			p.Conditions = &v1alpha1.RepositoryRulesetConditions{RefName: rn}
+		}
+	}
+	return p
+}
+
+// equateRulesets returns the options to compare the parameters of rulesets
+// regardless of the order GitHub returns lists in.
+func equateRulesets() []cmp.Option {
+	return []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b v1alpha1.RulesetRule) bool { return a.Type < b.Type }),
+		cmpopts.SortSlices(func(a, b v1alpha1.RulesetStatusCheck) bool { return a.Context < b.Context }),
+		cmpopts.SortSlices(func(a, b v1alpha1.RulesetBypassActor) bool {
+			if a.ActorType != b.ActorType {
+				return a.ActorType < b.ActorType
+			}
+			return ghclient.Int64Value(a.ActorID) < ghclient.Int64Value(b.ActorID)
+		}),
+	}
+}
+
+func generateBypassActors(actors []v1alpha1.RulesetBypassActor) []*BypassActor {
+	out := make([]*BypassActor, len(actors))
+	for i, a := range actors {
+		mode := bypassModeAlways
+		if a.BypassMode != nil {
+			mode = *a.BypassMode
+		}
+		out[i] = &BypassActor{ActorID: a.ActorID, ActorType: a.ActorType, BypassMode: &mode}
+	}
+	return out
+}
+
+func generateRulesetBypassActors(actors []*BypassActor) []v1alpha1.RulesetBypassActor {
+	if len(actors) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.RulesetBypassActor, len(actors))
+	for i, a := range actors {
+		out[i] = v1alpha1.RulesetBypassActor{ActorID: a.ActorID, ActorType: a.ActorType, BypassMode: a.BypassMode}
+	}
+	return out
+}
+
+func generateRefNameCondition(c *v1alpha1.RulesetRefNameCondition) *RefNameCondition {
+	return &RefNameCondition{Include: ghclient.StringSliceValue(c.Include), Exclude: ghclient.StringSliceValue(c.Exclude)}
+}
+
+func generateRulesetRefNameCondition(c *RefNameCondition) *v1alpha1.RulesetRefNameCondition {
+	if c == nil || (len(c.Include) == 0 && len(c.Exclude) == 0) {
+		return nil
+	}
+	return &v1alpha1.RulesetRefNameCondition{Include: c.Include, Exclude: c.Exclude}
+}
+
+func generateRules(rules []v1alpha1.RulesetRule) []*Rule {
+	out := make([]*Rule, len(rules))
+	for i, r := range rules {
+		out[i] = generateRule(r)
+	}
+	return out
+}
+
+func generateRule(r v1alpha1.RulesetRule) *Rule { // nolint:gocyclo
+	rule := &Rule{Type: r.Type}
+	switch r.Type {
+	case ruleUpdate:
+		u := v1alpha1.RulesetUpdateParameters{}
+		if r.Update != nil {
+			u = *r.Update
+		}
+		rule.Parameters = &RuleParameters{UpdateAllowsFetchAndMerge: &u.UpdateAllowsFetchAndMerge}
+	case ruleRequiredDeployments:
+		envs := []string{}
+		if r.RequiredDeployments != nil {
+			envs = ghclient.StringSliceValue(r.RequiredDeployments.Environments)
+		}
+		rule.Parameters = &RuleParameters{RequiredDeploymentEnvironments: &envs}
+	case rulePullRequest:
+		pr := v1alpha1.RulesetPullRequestParameters{}
+		if r.PullRequest != nil {
+			pr = *r.PullRequest
+		}
+		rule.Parameters = &RuleParameters{
+			DismissStaleReviewsOnPush:      &pr.DismissStaleReviewsOnPush,
+			RequireCodeOwnerReview:         &pr.RequireCodeOwnerReview,
+			RequireLastPushApproval:        &pr.RequireLastPushApproval,
+			RequiredApprovingReviewCount:   &pr.RequiredApprovingReviewCount,
+			RequiredReviewThreadResolution: &pr.RequiredReviewThreadResolution,
+		}
+	case ruleRequiredStatusChecks:
+		sc := v1alpha1.RulesetRequiredStatusChecksParameters{}
+		if r.RequiredStatusChecks != nil {
+			sc = *r.RequiredStatusChecks
+		}
+		checks := make([]StatusCheck, len(sc.StatusChecks))
+		for i, c := range sc.StatusChecks {
+			checks[i] = StatusCheck{Context: c.Context, IntegrationID: c.IntegrationID}
+		}
+		rule.Parameters = &RuleParameters{
+			RequiredStatusChecks:             &checks,
+			StrictRequiredStatusChecksPolicy: &sc.StrictRequiredStatusChecksPolicy,
+		}
+	case ruleCommitMessagePattern, ruleCommitAuthorEmailPattern, ruleCommitterEmailPattern, ruleBranchNamePattern, ruleTagNamePattern:
+		pt := v1alpha1.RulesetPatternParameters{}
+		if r.Pattern != nil {
+			pt = *r.Pattern
+		}
+		rule.Parameters = &RuleParameters{
+			Name:     pt.Name,
+			Negate:   &pt.Negate,
+			Operator: &pt.Operator,
+			Pattern:  &pt.Pattern,
+		}
+	}
+	return rule
+}
+
+func generateRulesetRules(rules []*Rule) []v1alpha1.RulesetRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.RulesetRule, len(rules))
+	for i, r := range rules {
+		out[i] = generateRulesetRule(r)
+	}
+	return out
+}
+
+func generateRulesetRule(r *Rule) v1alpha1.RulesetRule {
+	rule := v1alpha1.RulesetRule{Type: r.Type}
+	p := r.Parameters
+	if p == nil {
+		p = &RuleParameters{}
+	}
+	switch r.Type {
+	case ruleUpdate:
+		rule.Update = &v1alpha1.RulesetUpdateParameters{
+			UpdateAllowsFetchAndMerge: ghclient.BoolValue(p.UpdateAllowsFetchAndMerge),
+		}
+	case ruleRequiredDeployments:
+		rule.RequiredDeployments = &v1alpha1.RulesetRequiredDeploymentsParameters{}
+		if p.RequiredDeploymentEnvironments != nil {
+			rule.RequiredDeployments.Environments = *p.RequiredDeploymentEnvironments
+		}
+	case rulePullRequest:
+		rule.PullRequest = &v1alpha1.RulesetPullRequestParameters{
+			DismissStaleReviewsOnPush:      ghclient.BoolValue(p.DismissStaleReviewsOnPush),
+			RequireCodeOwnerReview:         ghclient.BoolValue(p.RequireCodeOwnerReview),
+			RequireLastPushApproval:        ghclient.BoolValue(p.RequireLastPushApproval),
+			RequiredApprovingReviewCount:   ghclient.IntValue(p.RequiredApprovingReviewCount),
+			RequiredReviewThreadResolution: ghclient.BoolValue(p.RequiredReviewThreadResolution),
+		}
+	case ruleRequiredStatusChecks:
+		rule.RequiredStatusChecks = &v1alpha1.RulesetRequiredStatusChecksParameters{
+			StrictRequiredStatusChecksPolicy: ghclient.BoolValue(p.StrictRequiredStatusChecksPolicy),
+		}
+		if p.RequiredStatusChecks != nil {
+			for _, c := range *p.RequiredStatusChecks {
+				rule.RequiredStatusChecks.StatusChecks = append(rule.RequiredStatusChecks.StatusChecks,
+					v1alpha1.RulesetStatusCheck{Context: c.Context, IntegrationID: c.IntegrationID})
+			}
+		}
+	case ruleCommitMessagePattern, ruleCommitAuthorEmailPattern, ruleCommitterEmailPattern, ruleBranchNamePattern, ruleTagNamePattern:
+		rule.Pattern = &v1alpha1.RulesetPatternParameters{
+			Name:     p.Name,
+			Negate:   ghclient.BoolValue(p.Negate),
+			Operator: ghclient.StringValue(p.Operator),
+			Pattern:  ghclient.StringValue(p.Pattern),
+		}
+	}
+	return rule
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	organizations "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// A ruleset as it is returned by GitHub.
+const fakeRuleset = `{
+  "id": 42,
+  "node_id": "RRS_fAKe",
+  "name": "default-branch",
+  "target": "branch",
+  "source_type": "Repository",
+  "source": "crossplane/sample",
+  "enforcement": "active",
+  "bypass_actors": [{"actor_id": 5, "actor_type": "RepositoryRole", "bypass_mode": "always"}],
+  "conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+  "rules": [
+    {"type": "deletion"},
+    {"type": "pull_request", "parameters": {
+      "dismiss_stale_reviews_on_push": true,
+      "require_code_owner_review": false,
+      "require_last_push_approval": false,
+      "required_approving_review_count": 2,
+      "required_review_thread_resolution": false
+    }},
+    {"type": "required_status_checks", "parameters": {
+      "required_status_checks": [{"context": "ci/lint"}, {"context": "ci/build", "integration_id": 1}],
+      "strict_required_status_checks_policy": true
+    }},
+    {"type": "branch_name_pattern", "parameters": {"operator": "starts_with", "pattern": "feature/", "negate": false}}
+  ]
+}`
+
+func params() v1alpha1.RepositoryRulesetParameters {
+	return v1alpha1.RepositoryRulesetParameters{
+		Owner:        "crossplane",
+		Repository:   "sample",
+		Name:         "default-branch",
+		Enforcement:  "active",
+		BypassActors: []v1alpha1.RulesetBypassActor{{ActorID: github.Int64(5), ActorType: "RepositoryRole"}},
+		Conditions: &v1alpha1.RepositoryRulesetConditions{
+			RefName: &v1alpha1.RulesetRefNameCondition{Include: []string{"~DEFAULT_BRANCH"}},
+		},
+		Rules: []v1alpha1.RulesetRule{
+			{
+				Type: "required_status_checks",
+				RequiredStatusChecks: &v1alpha1.RulesetRequiredStatusChecksParameters{
+					StatusChecks: []v1alpha1.RulesetStatusCheck{
+						{Context: "ci/build", IntegrationID: github.Int64(1)},
+						{Context: "ci/lint"},
+					},
+					StrictRequiredStatusChecksPolicy: true,
+				},
+			},
+			{Type: "deletion"},
+			{
+				Type:        "pull_request",
+				PullRequest: &v1alpha1.RulesetPullRequestParameters{DismissStaleReviewsOnPush: true, RequiredApprovingReviewCount: 2},
+			},
+			{
+				Type:    "branch_name_pattern",
+				Pattern: &v1alpha1.RulesetPatternParameters{Operator: "starts_with", Pattern: "feature/"},
+			},
+		},
+	}
+}
+
+func ruleset(t *testing.T) *Ruleset {
+	rs := &Ruleset{}
+	if err := json.Unmarshal([]byte(fakeRuleset), rs); err != nil {
+		t.Fatal(err)
+	}
+	return rs
+}
+
+func TestIsRepositoryRulesetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      func(p *v1alpha1.RepositoryRulesetParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Must return true regardless of defaults and the order of lists",
+			p:      func(p *v1alpha1.RepositoryRulesetParameters) {},
+			want:   true,
+		},
+		"EnforcementChanged": {
+			reason: "Must return false if the enforcement changed",
+			p:      func(p *v1alpha1.RepositoryRulesetParameters) { p.Enforcement = "evaluate" },
+			want:   false,
+		},
+		"TargetChanged": {
+			reason: "Must return false if the target changed",
+			p:      func(p *v1alpha1.RepositoryRulesetParameters) { p.Target = github.String("tag") },
+			want:   false,
+		},
+		"BypassModeChanged": {
+			reason: "Must return false if the bypass mode of an actor changed",
+			p: func(p *v1alpha1.RepositoryRulesetParameters) {
+				p.BypassActors[0].BypassMode = github.String("pull_request")
+			},
+			want: false,
+		},
+		"RuleParameterChanged": {
+			reason: "Must return false if a parameter of a rule changed",
+			p:      func(p *v1alpha1.RepositoryRulesetParameters) { p.Rules[2].PullRequest.RequireCodeOwnerReview = true },
+			want:   false,
+		},
+		"RuleAdded": {
+			reason: "Must return false if a rule was added",
+			p: func(p *v1alpha1.RepositoryRulesetParameters) {
+				p.Rules = append(p.Rules, v1alpha1.RulesetRule{Type: "required_signatures"})
+			},
+			want: false,
+		},
+		"ExcludeAdded": {
+			reason: "Must return false if a ref is excluded",
+			p: func(p *v1alpha1.RepositoryRulesetParameters) {
+				p.Conditions.RefName.Exclude = []string{"refs/heads/release"}
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := params()
+			tc.p(&p)
+			got := IsRepositoryRulesetUpToDate(p, ruleset(t))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsRepositoryRulesetUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeRepositoryRuleset(t *testing.T) {
+	p := v1alpha1.RepositoryRulesetParameters{Name: "default-branch", Enforcement: "active"}
+	LateInitializeRepositoryRuleset(&p, ruleset(t))
+
+	if diff := cmp.Diff(github.String("branch"), p.Target); diff != "" {
+		t.Errorf("LateInitializeRepositoryRuleset(...): -want target, +got target:\n%s", diff)
+	}
+	if !IsRepositoryRulesetUpToDate(p, ruleset(t)) {
+		t.Errorf("LateInitializeRepositoryRuleset(...): late initialized parameters must be up to date")
+	}
+}
+
+func TestServiceCreateRepositoryRuleset(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/crossplane/sample/rulesets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(fakeRuleset))
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	s := &service{client: gh}
+
+	p := params()
+	got, _, err := s.CreateRepositoryRuleset(context.Background(), p.Owner, p.Repository, GenerateRepositoryRuleset(p))
+	if err != nil {
+		t.Fatalf("CreateRepositoryRuleset(...): %s", err)
+	}
+	if diff := cmp.Diff(int64(42), ghclient.Int64Value(got.ID)); diff != "" {
+		t.Errorf("CreateRepositoryRuleset(...): -want ID, +got ID:\n%s", diff)
+	}
+
+	// GitHub requires all parameters of a rule, including those that are
+	// false.
+	want := map[string]interface{}{
+		"dismiss_stale_reviews_on_push":     true,
+		"require_code_owner_review":         false,
+		"require_last_push_approval":        false,
+		"required_approving_review_count":   float64(2),
+		"required_review_thread_resolution": false,
+	}
+	rules := body["rules"].([]interface{})
+	if diff := cmp.Diff(want, rules[2].(map[string]interface{})["parameters"]); diff != "" {
+		t.Errorf("CreateRepositoryRuleset(...): -want pull_request parameters, +got pull_request parameters:\n%s", diff)
+	}
+	if _, ok := rules[1].(map[string]interface{})["parameters"]; ok {
+		t.Errorf("CreateRepositoryRuleset(...): rules without parameters must not send any")
+	}
+}
+
+func TestIsOrganizationRulesetUpToDate(t *testing.T) {
+	observed := func() *Ruleset {
+		return &Ruleset{
+			Name:         "default-branches",
+			Target:       github.String("branch"),
+			Enforcement:  "active",
+			BypassActors: []*BypassActor{{ActorID: github.Int64(1), ActorType: "OrganizationAdmin", BypassMode: github.String("always")}},
+			Conditions: &Conditions{
+				RefName:        &RefNameCondition{Include: []string{"~DEFAULT_BRANCH"}, Exclude: []string{}},
+				RepositoryName: &RepositoryNameCondition{Include: []string{"~ALL"}, Exclude: []string{"sandbox-*"}},
+			},
+			Rules: []*Rule{{Type: "non_fast_forward"}, {Type: "deletion"}},
+		}
+	}
+	params := func() organizations.OrganizationRulesetParameters {
+		return organizations.OrganizationRulesetParameters{
+			Organization: "crossplane",
+			Name:         "default-branches",
+			Enforcement:  "active",
+			BypassActors: []v1alpha1.RulesetBypassActor{{ActorID: github.Int64(1), ActorType: "OrganizationAdmin"}},
+			Conditions: organizations.OrganizationRulesetConditions{
+				RefName:        &v1alpha1.RulesetRefNameCondition{Include: []string{"~DEFAULT_BRANCH"}},
+				RepositoryName: organizations.RulesetRepositoryNameCondition{Include: []string{"~ALL"}, Exclude: []string{"sandbox-*"}},
+			},
+			Rules: []v1alpha1.RulesetRule{{Type: "deletion"}, {Type: "non_fast_forward"}},
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      func(p *organizations.OrganizationRulesetParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Must return true if the ruleset did not change",
+			p:      func(p *organizations.OrganizationRulesetParameters) {},
+			want:   true,
+		},
+		"RepositoryExcluded": {
+			reason: "Must return false if the selected repositories changed",
+			p: func(p *organizations.OrganizationRulesetParameters) {
+				p.Conditions.RepositoryName.Exclude = append(p.Conditions.RepositoryName.Exclude, "archive-*")
+			},
+			want: false,
+		},
+		"RepositoriesProtected": {
+			reason: "Must return false if renaming the selected repositories must be prevented",
+			p:      func(p *organizations.OrganizationRulesetParameters) { p.Conditions.RepositoryName.Protected = true },
+			want:   false,
+		},
+		"RuleRemoved": {
+			reason: "Must return false if a rule was removed",
+			p:      func(p *organizations.OrganizationRulesetParameters) { p.Rules = p.Rules[:1] },
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := params()
+			tc.p(&p)
+			got := IsOrganizationRulesetUpToDate(p, observed())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsOrganizationRulesetUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/box"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+// AnnotationKeySecretVersion is the annotation that holds the version of the
+// Secret the secret value last sent to GitHub was read from. GitHub never
+// returns secret values, so it is the only way to tell whether the value
+// changed since without recording the value, or anything derived from it.
+const AnnotationKeySecretVersion = "github.crossplane.io/secret-version"
+
+// AnnotationKeyPublicKeyID is the annotation that holds the ID of the public
+// key the secret value last sent to GitHub was encrypted with. GitHub rotates
+// the public keys secrets are encrypted with from time to time.
+const AnnotationKeyPublicKeyID = "github.crossplane.io/public-key-id"
+
+const (
+	errGetSecret    = "cannot get Secret"
+	errNoSecretData = "Secret does not have the referenced key"
+	errPublicKey    = "cannot decode public key"
+	errEncrypt      = "cannot encrypt secret value"
+)
+
+// A SecretValue is the value of a key of a Secret.
+type SecretValue struct {
+	// Value of the key.
+	Value []byte
+
+	// Version of the key the value was read from. It changes whenever the
+	// value may have changed.
+	Version string
+}
+
+// SecretVersion returns the version of the supplied key of the supplied
+// Secret. It is made of the UID and the resource version of the Secret, so it
+// changes whenever the Secret is updated or replaced, and of the key, so it
+// changes when another key is referred to.
+func SecretVersion(s *corev1.Secret, key string) string {
+	return fmt.Sprintf("%s/%s/%s", s.GetUID(), s.GetResourceVersion(), key)
+}
+
+// GetSecretValue returns the value of the key of the Secret the supplied
+// selector refers to.
+func GetSecretValue(ctx context.Context, c client.Reader, ref xpv1.SecretKeySelector) (*SecretValue, error) {
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return nil, errors.New(errNoSecretData)
+	}
+	return &SecretValue{Value: v, Version: SecretVersion(s, ref.Key)}, nil
+}
+
+// IsSecretVersionUpToDate returns true if the supplied version is the one
+// recorded in the AnnotationKeySecretVersion annotation of the supplied
+// object. An empty version matches a missing annotation.
+func IsSecretVersionUpToDate(o metav1.Object, version string) bool {
+	return o.GetAnnotations()[AnnotationKeySecretVersion] == version
+}
+
+// SetSecretVersion records the supplied version in the
+// AnnotationKeySecretVersion annotation of the supplied object. The
+// annotation is removed if the version is empty.
+func SetSecretVersion(o metav1.Object, version string) {
+	if version == "" {
+		meta.RemoveAnnotations(o, AnnotationKeySecretVersion)
+		return
+	}
+	meta.AddAnnotations(o, map[string]string{AnnotationKeySecretVersion: version})
+}
+
+// EncryptSecret encrypts the supplied value of the secret with the supplied
+// name with the supplied public key. GitHub requires secret values to be
+// encrypted in a libsodium sealed box.
+func EncryptSecret(name string, key *github.PublicKey, v []byte) (*github.EncryptedSecret, error) {
+	k, err := base64.StdEncoding.DecodeString(key.GetKey())
+	if err != nil || len(k) != 32 {
+		return nil, errors.New(errPublicKey)
+	}
+	var recipient [32]byte
+	copy(recipient[:], k)
+	sealed, err := box.SealAnonymous(nil, v, &recipient, rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, errEncrypt)
+	}
+	return &github.EncryptedSecret{
+		Name:           name,
+		KeyID:          key.GetKeyID(),
+		EncryptedValue: base64.StdEncoding.EncodeToString(sealed),
+	}, nil
+}
+
+// IsEncryptedSecretUpToDate returns true if, according to the annotations of
+// the supplied object, the value of the supplied version was last sent to
+// GitHub encrypted with the public key with the supplied ID.
+func IsEncryptedSecretUpToDate(o metav1.Object, version, keyID string) bool {
+	return IsSecretVersionUpToDate(o, version) && o.GetAnnotations()[AnnotationKeyPublicKeyID] == keyID
+}
+
+// SetEncryptedSecret records the version of the value sent to GitHub and the
+// ID of the public key it was encrypted with in the annotations of the
+// supplied object.
+func SetEncryptedSecret(o metav1.Object, version, keyID string) {
+	meta.AddAnnotations(o, map[string]string{
+		AnnotationKeySecretVersion: version,
+		AnnotationKeyPublicKeyID:   keyID,
+	})
+}
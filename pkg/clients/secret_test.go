@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/box"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestGetSecretValue(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "webhook", Namespace: "crossplane-system"},
+		Key:             "secret",
+	}
+	withData := func(data map[string][]byte) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetUID("2f0b4f6a")
+			s.SetResourceVersion("7")
+			s.Data = data
+			return nil
+		}
+	}
+
+	type want struct {
+		value *SecretValue
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		kube   client.Reader
+		want   want
+	}{
+		"Found": {
+			reason: "Must return the value of the referenced key and the version it was read from",
+			kube:   &test.MockClient{MockGet: withData(map[string][]byte{"secret": []byte("s3cr3t")})},
+			want:   want{value: &SecretValue{Value: []byte("s3cr3t"), Version: "2f0b4f6a/7/secret"}},
+		},
+		"MissingKey": {
+			reason: "Must return an error if the Secret does not have the referenced key",
+			kube:   &test.MockClient{MockGet: withData(map[string][]byte{"other": []byte("s3cr3t")})},
+			want:   want{err: errors.New(errNoSecretData)},
+		},
+		"GetFailed": {
+			reason: "Must return an error if the Secret cannot be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want:   want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetSecretValue(context.Background(), tc.kube, ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetSecretValue(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.value, got); diff != "" {
+				t.Errorf("\n%s\nGetSecretValue(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetSecretVersion(t *testing.T) {
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		version     string
+		want        map[string]string
+	}{
+		"Set": {
+			reason:  "Must record the version in the annotation",
+			version: "2f0b4f6a/7/secret",
+			want:    map[string]string{AnnotationKeySecretVersion: "2f0b4f6a/7/secret"},
+		},
+		"Replace": {
+			reason:      "Must replace a previously recorded version",
+			annotations: map[string]string{AnnotationKeySecretVersion: "2f0b4f6a/7/secret"},
+			version:     "2f0b4f6a/8/secret",
+			want:        map[string]string{AnnotationKeySecretVersion: "2f0b4f6a/8/secret"},
+		},
+		"Remove": {
+			reason:      "Must remove the annotation if the version is empty",
+			annotations: map[string]string{AnnotationKeySecretVersion: "2f0b4f6a/7/secret", "other": "value"},
+			want:        map[string]string{"other": "value"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &metav1.ObjectMeta{Annotations: tc.annotations}
+			SetSecretVersion(o, tc.version)
+			if diff := cmp.Diff(tc.want, o.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\nSetSecretVersion(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if !IsSecretVersionUpToDate(o, tc.version) {
+				t.Errorf("\n%s\nIsSecretVersionUpToDate(...): want true after SetSecretVersion(...)", tc.reason)
+			}
+		})
+	}
+}
+
+func TestEncryptSecret(t *testing.T) {
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		value []byte
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		key    *github.PublicKey
+		want   want
+	}{
+		"Encrypted": {
+			reason: "Must encrypt the value so that the owner of the private key can decrypt it",
+			key:    &github.PublicKey{KeyID: github.String("568250167242549743"), Key: github.String(base64.StdEncoding.EncodeToString(pub[:]))},
+			want:   want{value: []byte("s3cr3t")},
+		},
+		"InvalidKey": {
+			reason: "Must return an error if the public key is not a base64 encoded 32 byte key",
+			key:    &github.PublicKey{KeyID: github.String("568250167242549743"), Key: github.String("invalid")},
+			want:   want{err: errors.New(errPublicKey)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := EncryptSecret("TOKEN", tc.key, []byte("s3cr3t"))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nEncryptSecret(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if got.Name != "TOKEN" || got.KeyID != tc.key.GetKeyID() {
+				t.Errorf("\n%s\nEncryptSecret(...): got name %q and key ID %q", tc.reason, got.Name, got.KeyID)
+			}
+			sealed, err := base64.StdEncoding.DecodeString(got.EncryptedValue)
+			if err != nil {
+				t.Fatalf("\n%s\nEncryptSecret(...): encrypted value is not base64 encoded: %v", tc.reason, err)
+			}
+			opened, ok := box.OpenAnonymous(nil, sealed, pub, priv)
+			if !ok {
+				t.Fatalf("\n%s\nEncryptSecret(...): cannot decrypt encrypted value", tc.reason)
+			}
+			if diff := cmp.Diff(tc.want.value, opened); diff != "" {
+				t.Errorf("\n%s\nEncryptSecret(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsEncryptedSecretUpToDate(t *testing.T) {
+	o := &metav1.ObjectMeta{}
+	SetEncryptedSecret(o, "2f0b4f6a/7/secret", "1")
+
+	cases := map[string]struct {
+		reason  string
+		version string
+		keyID   string
+		want    bool
+	}{
+		"UpToDate": {
+			reason:  "Must return true if the value was last encrypted with the current public key",
+			version: "2f0b4f6a/7/secret",
+			keyID:   "1",
+			want:    true,
+		},
+		"SecretChanged": {
+			reason:  "Must return false if the Secret the value is read from changed",
+			version: "2f0b4f6a/8/secret",
+			keyID:   "1",
+		},
+		"KeyRotated": {
+			reason:  "Must return false if GitHub rotated its public key",
+			version: "2f0b4f6a/7/secret",
+			keyID:   "2",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEncryptedSecretUpToDate(o, tc.version, tc.keyID)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsEncryptedSecretUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
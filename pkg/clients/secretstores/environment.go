@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// EnvironmentService defines the operations of the secret store of a
+// deployment environment. Environment secrets are only available to GitHub
+// Actions.
+type EnvironmentService interface {
+	GetEnvPublicKey(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error)
+	GetEnvSecret(ctx context.Context, repoID int64, env, name string) (*github.Secret, *github.Response, error)
+	CreateOrUpdateEnvSecret(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	DeleteEnvSecret(ctx context.Context, repoID int64, env, name string) (*github.Response, error)
+}
+
+// NewEnvironmentService creates a new EnvironmentService based on the
+// *github.Client returned by the GetClient SDK method.
+func NewEnvironmentService(cfg ghclient.Config) (*EnvironmentService, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := EnvironmentService(&service{client: c, store: StoreActions})
+	return &s, nil
+}
+
+func envSecrets(repoID int64, env string) string {
+	return fmt.Sprintf("repositories/%v/environments/%v/secrets", repoID, url.PathEscape(env))
+}
+
+func (s *service) GetEnvPublicKey(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error) {
+	return s.getPublicKey(ctx, fmt.Sprintf("%v/public-key", envSecrets(repoID, env)))
+}
+
+func (s *service) GetEnvSecret(ctx context.Context, repoID int64, env, name string) (*github.Secret, *github.Response, error) {
+	return s.getSecret(ctx, fmt.Sprintf("%v/%v", envSecrets(repoID, env), name))
+}
+
+func (s *service) CreateOrUpdateEnvSecret(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("%v/%v", envSecrets(repoID, env), eSecret.Name), eSecret)
+}
+
+func (s *service) DeleteEnvSecret(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("%v/%v", envSecrets(repoID, env), name), nil)
+}
+
+// GenerateEnvObservation produces EnvironmentSecretObservation object from
+// github.Secret object.
+func GenerateEnvObservation(s *github.Secret) v1alpha1.EnvironmentSecretObservation {
+	return v1alpha1.EnvironmentSecretObservation{
+		CreatedAt: ghclient.ConvertTimestamp(&s.CreatedAt),
+		UpdatedAt: ghclient.ConvertTimestamp(&s.UpdatedAt),
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v33/github"
+
+	orgsv1alpha1 "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// The secret stores of a repository or an organization. Their secrets are
+// encrypted, addressed and restricted to repositories the same way, but each
+// store has its own public key and its secrets are only available to GitHub
+// Actions, Dependabot or Codespaces respectively.
+const (
+	StoreActions    = "actions"
+	StoreDependabot = "dependabot"
+	StoreCodespaces = "codespaces"
+)
+
+// Service defines the operations of a secret store
+type Service interface {
+	GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error)
+	GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error)
+	CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*github.Response, error)
+	GetOrgPublicKey(ctx context.Context, org string) (*github.PublicKey, *github.Response, error)
+	GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
+	CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	DeleteOrgSecret(ctx context.Context, org, name string) (*github.Response, error)
+	ListSelectedReposForOrgSecret(ctx context.Context, org, name string) ([]*github.Repository, error)
+}
+
+// NewService creates a new Service for the given secret store based on the
+// *github.Client returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config, store string) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c, store: store})
+	return &s, nil
+}
+
+// service sends raw requests, so that all the stores share the same paths.
+// Only the Actions store is supported by go-github yet.
+type service struct {
+	client *github.Client
+	store  string
+}
+
+func (s *service) repoSecrets(owner, repo string) string {
+	return fmt.Sprintf("repos/%v/%v/%v/secrets", owner, repo, s.store)
+}
+
+func (s *service) orgSecrets(org string) string {
+	return fmt.Sprintf("orgs/%v/%v/secrets", org, s.store)
+}
+
+func (s *service) getPublicKey(ctx context.Context, u string) (*github.PublicKey, *github.Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	k := &github.PublicKey{}
+	res, err := s.client.Do(ctx, req, k)
+	if err != nil {
+		return nil, res, err
+	}
+	return k, res, nil
+}
+
+func (s *service) getSecret(ctx context.Context, u string) (*github.Secret, *github.Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	secret := &github.Secret{}
+	res, err := s.client.Do(ctx, req, secret)
+	if err != nil {
+		return nil, res, err
+	}
+	return secret, res, nil
+}
+
+func (s *service) do(ctx context.Context, method, u string, body interface{}) (*github.Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *service) GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+	return s.getPublicKey(ctx, fmt.Sprintf("%v/public-key", s.repoSecrets(owner, repo)))
+}
+
+func (s *service) GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+	return s.getSecret(ctx, fmt.Sprintf("%v/%v", s.repoSecrets(owner, repo), name))
+}
+
+func (s *service) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("%v/%v", s.repoSecrets(owner, repo), eSecret.Name), eSecret)
+}
+
+func (s *service) DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("%v/%v", s.repoSecrets(owner, repo), name), nil)
+}
+
+func (s *service) GetOrgPublicKey(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+	return s.getPublicKey(ctx, fmt.Sprintf("%v/public-key", s.orgSecrets(org)))
+}
+
+func (s *service) GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+	return s.getSecret(ctx, fmt.Sprintf("%v/%v", s.orgSecrets(org), name))
+}
+
+// orgSecret is the body of an organization secret. go-github omits an empty
+// selection of repositories, which GitHub then keeps as it is.
+type orgSecret struct {
+	KeyID                 string                  `json:"key_id"`
+	EncryptedValue        string                  `json:"encrypted_value"`
+	Visibility            string                  `json:"visibility,omitempty"`
+	SelectedRepositoryIDs *github.SelectedRepoIDs `json:"selected_repository_ids,omitempty"`
+}
+
+func (s *service) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	body := &orgSecret{
+		KeyID:          eSecret.KeyID,
+		EncryptedValue: eSecret.EncryptedValue,
+		Visibility:     eSecret.Visibility,
+	}
+	if eSecret.Visibility == orgsv1alpha1.VisibilitySelected {
+		ids := append(github.SelectedRepoIDs{}, eSecret.SelectedRepositoryIDs...)
+		body.SelectedRepositoryIDs = &ids
+	}
+	return s.do(ctx, "PUT", fmt.Sprintf("%v/%v", s.orgSecrets(org), eSecret.Name), body)
+}
+
+func (s *service) DeleteOrgSecret(ctx context.Context, org, name string) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("%v/%v", s.orgSecrets(org), name), nil)
+}
+
+func (s *service) ListSelectedReposForOrgSecret(ctx context.Context, org, name string) ([]*github.Repository, error) {
+	return ghclient.ListSelectedRepositories(ctx, s.client, fmt.Sprintf("%v/%v/repositories", s.orgSecrets(org), name))
+}
+
+// GenerateObservation produces ActionsSecretObservation object from
+// github.Secret object. The observations of the secrets of the other stores
+// have the same fields.
+func GenerateObservation(s *github.Secret) v1alpha1.ActionsSecretObservation {
+	return v1alpha1.ActionsSecretObservation{
+		CreatedAt: ghclient.ConvertTimestamp(&s.CreatedAt),
+		UpdatedAt: ghclient.ConvertTimestamp(&s.UpdatedAt),
+	}
+}
+
+// GenerateOrgSecret produces the visibility and the selected repositories of
+// an organization secret from OrganizationActionsSecretParameters. The
+// encrypted value is set by the caller.
+func GenerateOrgSecret(p orgsv1alpha1.OrganizationActionsSecretParameters, s *github.EncryptedSecret) {
+	ghclient.SetSecretVisibility(s, p.Visibility, p.SelectedRepositoryIDs)
+}
+
+// IsOrgSecretUpToDate checks whether the visibility and the selected
+// repositories of an organization secret are the ones given in
+// OrganizationActionsSecretParameters.
+func IsOrgSecretUpToDate(p orgsv1alpha1.OrganizationActionsSecretParameters, s *github.Secret, repos []*github.Repository) bool {
+	return ghclient.IsVisibilityUpToDate(p.Visibility, p.SelectedRepositoryIDs, s.Visibility, repos)
+}
+
+// GenerateOrgObservation produces OrganizationActionsSecretObservation object
+// from github.Secret object and the repositories that can access it. The
+// observations of the organization secrets of the other stores have the same
+// fields.
+func GenerateOrgObservation(s *github.Secret, repos []*github.Repository) orgsv1alpha1.OrganizationActionsSecretObservation {
+	return orgsv1alpha1.OrganizationActionsSecretObservation{
+		Visibility:           s.Visibility,
+		SelectedRepositories: ghclient.SelectedRepositoryNames(repos),
+		CreatedAt:            ghclient.ConvertTimestamp(&s.CreatedAt),
+		UpdatedAt:            ghclient.ConvertTimestamp(&s.UpdatedAt),
+	}
+}
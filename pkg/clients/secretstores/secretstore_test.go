@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	orgsv1alpha1 "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func TestServiceCreateOrUpdateOrgSecret(t *testing.T) {
+	cases := map[string]struct {
+		store string
+		path  string
+		ids   github.SelectedRepoIDs
+		want  []interface{}
+	}{
+		"Actions": {
+			store: StoreActions,
+			path:  "/orgs/crossplane/actions/secrets/NPM_TOKEN",
+			ids:   github.SelectedRepoIDs{1296269},
+			want:  []interface{}{float64(1296269)},
+		},
+		"Dependabot": {
+			store: StoreDependabot,
+			path:  "/orgs/crossplane/dependabot/secrets/NPM_TOKEN",
+			ids:   github.SelectedRepoIDs{1296269},
+			want:  []interface{}{float64(1296269)},
+		},
+		"Codespaces": {
+			store: StoreCodespaces,
+			path:  "/orgs/crossplane/codespaces/secrets/NPM_TOKEN",
+			ids:   github.SelectedRepoIDs{1296269},
+			want:  []interface{}{float64(1296269)},
+		},
+		"NoneSelected": {
+			store: StoreActions,
+			path:  "/orgs/crossplane/actions/secrets/NPM_TOKEN",
+			want:  []interface{}{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got map[string]interface{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PUT" || r.URL.Path != tc.path {
+					t.Errorf("CreateOrUpdateOrgSecret(...): unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				_ = json.NewDecoder(r.Body).Decode(&got)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer srv.Close()
+
+			gh := github.NewClient(nil)
+			gh.BaseURL, _ = url.Parse(srv.URL + "/")
+			s := &service{client: gh, store: tc.store}
+
+			_, err := s.CreateOrUpdateOrgSecret(context.Background(), "crossplane", &github.EncryptedSecret{
+				Name:                  "NPM_TOKEN",
+				KeyID:                 "1234",
+				EncryptedValue:        "c2VhbGVk",
+				Visibility:            "selected",
+				SelectedRepositoryIDs: tc.ids,
+			})
+			if err != nil {
+				t.Fatalf("CreateOrUpdateOrgSecret(...): %s", err)
+			}
+			want := map[string]interface{}{
+				"key_id":                  "1234",
+				"encrypted_value":         "c2VhbGVk",
+				"visibility":              "selected",
+				"selected_repository_ids": tc.want,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("CreateOrUpdateOrgSecret(...): -want body, +got body:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	created := time.Date(2021, 1, 10, 14, 59, 22, 0, time.UTC)
+	updated := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	want := v1alpha1.ActionsSecretObservation{
+		CreatedAt: &metav1.Time{Time: created},
+		UpdatedAt: &metav1.Time{Time: updated},
+	}
+	got := GenerateObservation(&github.Secret{
+		Name:      "TOKEN",
+		CreatedAt: github.Timestamp{Time: created},
+		UpdatedAt: github.Timestamp{Time: updated},
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nGenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsOrgSecretUpToDate(t *testing.T) {
+	repos := []*github.Repository{{ID: github.Int64(1)}, {ID: github.Int64(2)}}
+
+	type args struct {
+		p     orgsv1alpha1.OrganizationActionsSecretParameters
+		s     *github.Secret
+		repos []*github.Repository
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"VisibilityUpToDate": {
+			args: args{
+				p: orgsv1alpha1.OrganizationActionsSecretParameters{Visibility: "private"},
+				s: &github.Secret{Visibility: "private"},
+			},
+			want: true,
+		},
+		"VisibilityChanged": {
+			args: args{
+				p: orgsv1alpha1.OrganizationActionsSecretParameters{Visibility: "all"},
+				s: &github.Secret{Visibility: "private"},
+			},
+			want: false,
+		},
+		"SelectedRepositoriesUpToDate": {
+			args: args{
+				p:     orgsv1alpha1.OrganizationActionsSecretParameters{Visibility: "selected", SelectedRepositoryIDs: []int64{2, 1}},
+				s:     &github.Secret{Visibility: "selected"},
+				repos: repos,
+			},
+			want: true,
+		},
+		"RepositorySelected": {
+			args: args{
+				p:     orgsv1alpha1.OrganizationActionsSecretParameters{Visibility: "selected", SelectedRepositoryIDs: []int64{1, 2, 3}},
+				s:     &github.Secret{Visibility: "selected"},
+				repos: repos,
+			},
+			want: false,
+		},
+		"RepositoryReplaced": {
+			args: args{
+				p:     orgsv1alpha1.OrganizationActionsSecretParameters{Visibility: "selected", SelectedRepositoryIDs: []int64{1, 3}},
+				s:     &github.Secret{Visibility: "selected"},
+				repos: repos,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOrgSecretUpToDate(tc.args.p, tc.args.s, tc.args.repos)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nIsOrgSecretUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateOrgObservation(t *testing.T) {
+	created := time.Date(2021, 1, 10, 14, 59, 22, 0, time.UTC)
+
+	want := orgsv1alpha1.OrganizationActionsSecretObservation{
+		Visibility:           "selected",
+		SelectedRepositories: []string{"api", "web"},
+		CreatedAt:            &metav1.Time{Time: created},
+		UpdatedAt:            &metav1.Time{Time: created},
+	}
+	got := GenerateOrgObservation(&github.Secret{
+		Name:       "TOKEN",
+		Visibility: "selected",
+		CreatedAt:  github.Timestamp{Time: created},
+		UpdatedAt:  github.Timestamp{Time: created},
+	}, []*github.Repository{{Name: github.String("web")}, {Name: github.String("api")}})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nGenerateOrgObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teammemberships
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// Service defines the Team membership operations
+type Service interface {
+	GetTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error)
+	AddTeamMembershipBySlug(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error)
+	RemoveTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(c.Teams)
+	return &s, nil
+}
+
+// GenerateAddOptions produces the options to add a user to a Team from
+// TeamMembershipParameters.
+func GenerateAddOptions(p v1alpha1.TeamMembershipParameters) *github.TeamAddTeamMembershipOptions {
+	return &github.TeamAddTeamMembershipOptions{Role: ghclient.StringValue(p.Role)}
+}
+
+// IsUpToDate checks whether the membership is configured with given
+// TeamMembershipParameters.
+func IsUpToDate(p v1alpha1.TeamMembershipParameters, m *github.Membership) bool {
+	return p.Role == nil || *p.Role == m.GetRole()
+}
+
+// LateInitialize fills the empty fields of TeamMembershipParameters if the
+// corresponding fields are given in Membership.
+func LateInitialize(p *v1alpha1.TeamMembershipParameters, m *github.Membership) {
+	if p.Role == nil && m.Role != nil {
+		p.Role = m.Role
+	}
+}
+
+// GenerateObservation produces TeamMembershipObservation object from
+// github.Membership object.
+func GenerateObservation(m github.Membership) v1alpha1.TeamMembershipObservation {
+	return v1alpha1.TeamMembershipObservation{
+		URL:   ghclient.StringValue(m.URL),
+		State: ghclient.StringValue(m.State),
+		Role:  ghclient.StringValue(m.Role),
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teammemberships
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+)
+
+var (
+	fakeURL        = "https://api.github.com/organizations/1/team/2/memberships/octocat"
+	fakeActive     = "active"
+	fakeMember     = "member"
+	fakeMaintainer = "maintainer"
+)
+
+func membership() *github.Membership {
+	return &github.Membership{URL: &fakeURL, State: &fakeActive, Role: &fakeMember}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.TeamMembershipParameters
+		want   bool
+	}{
+		"SameRole": {
+			reason: "Must return true if the role did not change",
+			p:      v1alpha1.TeamMembershipParameters{Role: &fakeMember},
+			want:   true,
+		},
+		"NoRole": {
+			reason: "Must return true if no role is desired",
+			p:      v1alpha1.TeamMembershipParameters{},
+			want:   true,
+		},
+		"RoleChanged": {
+			reason: "Must return false if the role changed",
+			p:      v1alpha1.TeamMembershipParameters{Role: &fakeMaintainer},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, membership())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      *v1alpha1.TeamMembershipParameters
+		want   *v1alpha1.TeamMembershipParameters
+	}{
+		"Empty": {
+			reason: "Must late initialize the role",
+			p:      &v1alpha1.TeamMembershipParameters{},
+			want:   &v1alpha1.TeamMembershipParameters{Role: &fakeMember},
+		},
+		"Set": {
+			reason: "Must not override the desired role",
+			p:      &v1alpha1.TeamMembershipParameters{Role: &fakeMaintainer},
+			want:   &v1alpha1.TeamMembershipParameters{Role: &fakeMaintainer},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, membership())
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("\n%s\nLateInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	want := v1alpha1.TeamMembershipObservation{URL: fakeURL, State: fakeActive, Role: fakeMember}
+	if diff := cmp.Diff(want, GenerateObservation(*membership())); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teamrepositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// GitHub only returns the repository, including the permission of the Team,
+// when it is requested with this media type.
+const mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
+
+// A Repository is a repository a Team has access to. The role name, which
+// identifies custom repository roles, is not supported by go-github yet.
+type Repository struct {
+	github.Repository
+	RoleName *string `json:"role_name,omitempty"`
+}
+
+// Service defines the Team repository operations
+type Service interface {
+	Get(ctx context.Context, org, slug, owner, repo string) (*Repository, *github.Response, error)
+	Add(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error)
+	Remove(ctx context.Context, org, slug, owner, repo string) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func (s *service) Get(ctx context.Context, org, slug, owner, repo string) (*Repository, *github.Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", org, slug, owner, repo), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeOrgPermissionRepo)
+
+	r := &Repository{}
+	res, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, res, err
+	}
+	return r, res, nil
+}
+
+func (s *service) Add(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error) {
+	return s.client.Teams.AddTeamRepoBySlug(ctx, org, slug, owner, repo, &github.TeamAddTeamRepoOptions{Permission: permission})
+}
+
+func (s *service) Remove(ctx context.Context, org, slug, owner, repo string) (*github.Response, error) {
+	return s.client.Teams.RemoveTeamRepoBySlug(ctx, org, slug, owner, repo)
+}
+
+// Permission returns the permission of the Team on the supplied Repository,
+// in the form it is granted in. Custom repository roles are returned by name.
+func Permission(r *Repository) string {
+	if name := ghclient.StringValue(r.RoleName); name != "" {
+		return ghclient.RepositoryPermission(name)
+	}
+
+	// GitHub Enterprise Server versions without custom repository roles
+	// only return the permissions the role is made of.
+	p := r.GetPermissions()
+	for _, permission := range ghclient.RepositoryPermissions {
+		if p[permission] {
+			return permission
+		}
+	}
+	return ""
+}
+
+// IsUpToDate checks whether the Team has the permission given in
+// TeamRepositoryParameters on the Repository.
+func IsUpToDate(p v1alpha1.TeamRepositoryParameters, r *Repository) bool {
+	return p.Permission == Permission(r)
+}
+
+// GenerateObservation produces TeamRepositoryObservation object from
+// Repository object.
+func GenerateObservation(r *Repository) v1alpha1.TeamRepositoryObservation {
+	return v1alpha1.TeamRepositoryObservation{
+		Permission: Permission(r),
+		FullName:   r.GetFullName(),
+	}
+}
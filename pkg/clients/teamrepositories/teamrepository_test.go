@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teamrepositories
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+)
+
+func TestPermission(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		r      *Repository
+		want   string
+	}{
+		"BuiltInRole": {
+			reason: "Must return built-in roles the way they are granted",
+			r:      &Repository{RoleName: github.String("write")},
+			want:   "push",
+		},
+		"CustomRole": {
+			reason: "Must return custom roles by name",
+			r:      &Repository{RoleName: github.String("security-engineer")},
+			want:   "security-engineer",
+		},
+		"Permissions": {
+			reason: "Must return the most privileged permission if the role name is unknown",
+			r: &Repository{Repository: github.Repository{Permissions: &map[string]bool{
+				"admin": false, "maintain": true, "push": true, "triage": true, "pull": true,
+			}}},
+			want: "maintain",
+		},
+		"NoPermissions": {
+			reason: "Must return an empty permission if the Team has none",
+			r:      &Repository{},
+			want:   "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Permission(tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPermission(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServiceGet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/crossplane/teams/platform/repos/crossplane/sample" {
+			t.Errorf("Get(...): unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Accept") != mediaTypeOrgPermissionRepo {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(`{"full_name":"crossplane/sample","role_name":"triage"}`))
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	s := &service{client: gh}
+
+	got, _, err := s.Get(context.Background(), "crossplane", "platform", "crossplane", "sample")
+	if err != nil {
+		t.Fatalf("Get(...): %s", err)
+	}
+	if diff := cmp.Diff("triage", Permission(got)); diff != "" {
+		t.Errorf("Get(...): -want permission, +got permission:\n%s", diff)
+	}
+	if diff := cmp.Diff("crossplane/sample", got.GetFullName()); diff != "" {
+		t.Errorf("Get(...): -want full name, +got full name:\n%s", diff)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teams
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// A Team is a GitHub Team. The notification setting of a Team is not
+// supported by go-github yet.
+type Team struct {
+	github.Team
+	NotificationSetting *string `json:"notification_setting,omitempty"`
+}
+
+// GetNotificationSetting returns the NotificationSetting field if it's
+// non-nil, zero value otherwise.
+func (t *Team) GetNotificationSetting() string {
+	if t == nil || t.NotificationSetting == nil {
+		return ""
+	}
+	return *t.NotificationSetting
+}
+
+// A TeamRequest is the body of a request to create or edit a Team.
+type TeamRequest struct {
+	github.NewTeam
+	NotificationSetting *string `json:"notification_setting,omitempty"`
+}
+
+// Service defines the Teams operations
+type Service interface {
+	Get(ctx context.Context, org, slug string) (*Team, *github.Response, error)
+	Create(ctx context.Context, org string, team *TeamRequest) (*Team, *github.Response, error)
+	Edit(ctx context.Context, org, slug string, team *TeamRequest) (*Team, *github.Response, error)
+	Delete(ctx context.Context, org, slug string) (*github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func (s *service) Get(ctx context.Context, org, slug string) (*Team, *github.Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("orgs/%v/teams/%v", org, slug), nil)
+}
+
+func (s *service) Create(ctx context.Context, org string, team *TeamRequest) (*Team, *github.Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("orgs/%v/teams", org), team)
+}
+
+func (s *service) Edit(ctx context.Context, org, slug string, team *TeamRequest) (*Team, *github.Response, error) {
+	return s.do(ctx, "PATCH", fmt.Sprintf("orgs/%v/teams/%v", org, slug), team)
+}
+
+func (s *service) Delete(ctx context.Context, org, slug string) (*github.Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("orgs/%v/teams/%v", org, slug), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *service) do(ctx context.Context, method, u string, body interface{}) (*Team, *github.Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	t := &Team{}
+	res, err := s.client.Do(ctx, req, t)
+	if err != nil {
+		return nil, res, err
+	}
+	return t, res, nil
+}
+
+// GenerateTeamRequest produces a TeamRequest from TeamParameters. The supplied
+// name is used if the TeamParameters do not specify one.
+func GenerateTeamRequest(tp v1alpha1.TeamParameters, name string) *TeamRequest {
+	if tp.Name != nil {
+		name = *tp.Name
+	}
+	return &TeamRequest{
+		NewTeam: github.NewTeam{
+			Name:         name,
+			Description:  tp.Description,
+			Privacy:      tp.Privacy,
+			ParentTeamID: tp.ParentTeamID,
+			LDAPDN:       tp.LDAPDN,
+		},
+		NotificationSetting: tp.NotificationSetting,
+	}
+}
+
+// IsUpToDate checks whether Team is configured with given TeamParameters.
+func IsUpToDate(tp v1alpha1.TeamParameters, t *Team) bool {
+	switch {
+	case tp.Name != nil && *tp.Name != t.GetName():
+		return false
+	case tp.Description != nil && *tp.Description != t.GetDescription():
+		return false
+	case tp.Privacy != nil && *tp.Privacy != t.GetPrivacy():
+		return false
+	case tp.ParentTeamID != nil && *tp.ParentTeamID != t.GetParent().GetID():
+		return false
+	case tp.NotificationSetting != nil && *tp.NotificationSetting != t.GetNotificationSetting():
+		return false
+	case tp.LDAPDN != nil && *tp.LDAPDN != t.GetLDAPDN():
+		return false
+	}
+	return true
+}
+
+// LateInitialize fills the empty fields of TeamParameters if the corresponding
+// fields are given in Team.
+func LateInitialize(tp *v1alpha1.TeamParameters, t *Team) {
+	if tp.Name == nil && t.Name != nil {
+		tp.Name = t.Name
+	}
+	if tp.Description == nil && t.Description != nil {
+		tp.Description = t.Description
+	}
+	if tp.Privacy == nil && t.Privacy != nil {
+		tp.Privacy = t.Privacy
+	}
+	if tp.ParentTeamID == nil && t.Parent != nil && t.Parent.ID != nil {
+		tp.ParentTeamID = t.Parent.ID
+	}
+	if tp.NotificationSetting == nil && t.NotificationSetting != nil {
+		tp.NotificationSetting = t.NotificationSetting
+	}
+	if tp.LDAPDN == nil && t.LDAPDN != nil {
+		tp.LDAPDN = t.LDAPDN
+	}
+}
+
+// GenerateObservation produces TeamObservation object from Team object.
+func GenerateObservation(t Team) v1alpha1.TeamObservation {
+	return v1alpha1.TeamObservation{
+		ID:           ghclient.Int64Value(t.ID),
+		NodeID:       ghclient.StringValue(t.NodeID),
+		Slug:         ghclient.StringValue(t.Slug),
+		URL:          ghclient.StringValue(t.URL),
+		MembersCount: ghclient.IntValue(t.MembersCount),
+		ReposCount:   ghclient.IntValue(t.ReposCount),
+	}
+}
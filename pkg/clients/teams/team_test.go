@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+)
+
+var (
+	fakeID           = int64(1)
+	fakeParentID     = int64(2)
+	fakeNodeID       = "fAKe"
+	fakeSlug         = "platform-team"
+	fakeName         = "Platform Team"
+	fakeDescription  = "sample description"
+	fakePrivacy      = "closed"
+	fakeNotification = "notifications_disabled"
+	fakeMembers      = 3
+)
+
+func params() *v1alpha1.TeamParameters {
+	return &v1alpha1.TeamParameters{
+		Organization:        "crossplane",
+		Name:                &fakeName,
+		Description:         &fakeDescription,
+		Privacy:             &fakePrivacy,
+		ParentTeamID:        &fakeParentID,
+		NotificationSetting: &fakeNotification,
+	}
+}
+
+func team() *Team {
+	return &Team{
+		Team: github.Team{
+			ID:           &fakeID,
+			NodeID:       &fakeNodeID,
+			Slug:         &fakeSlug,
+			Name:         &fakeName,
+			Description:  &fakeDescription,
+			Privacy:      &fakePrivacy,
+			Parent:       &github.Team{ID: &fakeParentID},
+			MembersCount: &fakeMembers,
+		},
+		NotificationSetting: &fakeNotification,
+	}
+}
+
+func TestGenerateTeamRequest(t *testing.T) {
+	type args struct {
+		tp   v1alpha1.TeamParameters
+		name string
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   *TeamRequest
+	}{
+		"SpecName": {
+			reason: "Must use the name of the TeamParameters if it is set",
+			args:   args{tp: *params(), name: fakeSlug},
+			want: &TeamRequest{
+				NewTeam: github.NewTeam{
+					Name:         fakeName,
+					Description:  &fakeDescription,
+					Privacy:      &fakePrivacy,
+					ParentTeamID: &fakeParentID,
+				},
+				NotificationSetting: &fakeNotification,
+			},
+		},
+		"ExternalName": {
+			reason: "Must use the supplied name if the TeamParameters do not set one",
+			args:   args{tp: v1alpha1.TeamParameters{}, name: fakeSlug},
+			want:   &TeamRequest{NewTeam: github.NewTeam{Name: fakeSlug}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTeamRequest(tc.args.tp, tc.args.name)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateTeamRequest(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	otherParentID := int64(3)
+	cases := map[string]struct {
+		reason string
+		tp     *v1alpha1.TeamParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Must return true if the Team matches the TeamParameters",
+			tp:     params(),
+			want:   true,
+		},
+		"Unset": {
+			reason: "Must ignore fields that are not set in the TeamParameters",
+			tp:     &v1alpha1.TeamParameters{},
+			want:   true,
+		},
+		"ParentChanged": {
+			reason: "Must return false if the parent Team changed",
+			tp: func() *v1alpha1.TeamParameters {
+				tp := params()
+				tp.ParentTeamID = &otherParentID
+				return tp
+			}(),
+			want: false,
+		},
+		"NotificationSettingChanged": {
+			reason: "Must return false if the notification setting changed",
+			tp: func() *v1alpha1.TeamParameters {
+				tp := params()
+				enabled := "notifications_enabled"
+				tp.NotificationSetting = &enabled
+				return tp
+			}(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(*tc.tp, team())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	tp := &v1alpha1.TeamParameters{Organization: "crossplane"}
+	LateInitialize(tp, team())
+	if diff := cmp.Diff(params(), tp); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	want := v1alpha1.TeamObservation{
+		ID:           fakeID,
+		NodeID:       fakeNodeID,
+		Slug:         fakeSlug,
+		MembersCount: fakeMembers,
+	}
+	if diff := cmp.Diff(want, GenerateObservation(*team())); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestServiceCreate(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/orgs/crossplane/teams" {
+			t.Errorf("Create(...): unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":1,"slug":"platform-team","notification_setting":"notifications_disabled"}`))
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	s := &service{client: gh}
+
+	got, _, err := s.Create(context.Background(), "crossplane", GenerateTeamRequest(*params(), fakeSlug))
+	if err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+	if diff := cmp.Diff(fakeNotification, body["notification_setting"]); diff != "" {
+		t.Errorf("Create(...): -want sent notification setting, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(fakeNotification, got.GetNotificationSetting()); diff != "" {
+		t.Errorf("Create(...): -want notification setting, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(fakeSlug, got.GetSlug()); diff != "" {
+		t.Errorf("Create(...): -want slug, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orgsv1alpha1 "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+const errNoValue = "neither a value nor a ConfigMap holding it is given"
+
+// A Variable is a GitHub Actions configuration variable. Variables are not
+// supported by go-github yet. The selected repository IDs are a pointer so
+// that an empty selection is sent, GitHub keeps the previous one otherwise.
+type Variable struct {
+	Name                  string            `json:"name"`
+	Value                 string            `json:"value"`
+	Visibility            string            `json:"visibility,omitempty"`
+	SelectedRepositoryIDs *[]int64          `json:"selected_repository_ids,omitempty"`
+	CreatedAt             *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt             *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// Service defines the GitHub Actions variable operations
+type Service interface {
+	GetRepoVariable(ctx context.Context, owner, repo, name string) (*Variable, *github.Response, error)
+	CreateRepoVariable(ctx context.Context, owner, repo string, v *Variable) (*github.Response, error)
+	UpdateRepoVariable(ctx context.Context, owner, repo string, v *Variable) (*github.Response, error)
+	DeleteRepoVariable(ctx context.Context, owner, repo, name string) (*github.Response, error)
+	GetEnvVariable(ctx context.Context, repoID int64, env, name string) (*Variable, *github.Response, error)
+	CreateEnvVariable(ctx context.Context, repoID int64, env string, v *Variable) (*github.Response, error)
+	UpdateEnvVariable(ctx context.Context, repoID int64, env string, v *Variable) (*github.Response, error)
+	DeleteEnvVariable(ctx context.Context, repoID int64, env, name string) (*github.Response, error)
+	GetOrgVariable(ctx context.Context, org, name string) (*Variable, *github.Response, error)
+	CreateOrgVariable(ctx context.Context, org string, v *Variable) (*github.Response, error)
+	UpdateOrgVariable(ctx context.Context, org string, v *Variable) (*github.Response, error)
+	DeleteOrgVariable(ctx context.Context, org, name string) (*github.Response, error)
+	ListSelectedReposForOrgVariable(ctx context.Context, org, name string) ([]*github.Repository, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func (s *service) get(ctx context.Context, u string) (*Variable, *github.Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	v := &Variable{}
+	res, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, res, err
+	}
+	return v, res, nil
+}
+
+func (s *service) do(ctx context.Context, method, u string, body interface{}) (*github.Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func repoVariables(owner, repo string) string {
+	return fmt.Sprintf("repos/%v/%v/actions/variables", owner, repo)
+}
+
+func envVariables(repoID int64, env string) string {
+	return fmt.Sprintf("repositories/%v/environments/%v/variables", repoID, url.PathEscape(env))
+}
+
+func orgVariables(org string) string {
+	return fmt.Sprintf("orgs/%v/actions/variables", org)
+}
+
+func (s *service) GetRepoVariable(ctx context.Context, owner, repo, name string) (*Variable, *github.Response, error) {
+	return s.get(ctx, fmt.Sprintf("%v/%v", repoVariables(owner, repo), name))
+}
+
+func (s *service) CreateRepoVariable(ctx context.Context, owner, repo string, v *Variable) (*github.Response, error) {
+	return s.do(ctx, "POST", repoVariables(owner, repo), v)
+}
+
+func (s *service) UpdateRepoVariable(ctx context.Context, owner, repo string, v *Variable) (*github.Response, error) {
+	return s.do(ctx, "PATCH", fmt.Sprintf("%v/%v", repoVariables(owner, repo), v.Name), v)
+}
+
+func (s *service) DeleteRepoVariable(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("%v/%v", repoVariables(owner, repo), name), nil)
+}
+
+func (s *service) GetEnvVariable(ctx context.Context, repoID int64, env, name string) (*Variable, *github.Response, error) {
+	return s.get(ctx, fmt.Sprintf("%v/%v", envVariables(repoID, env), name))
+}
+
+func (s *service) CreateEnvVariable(ctx context.Context, repoID int64, env string, v *Variable) (*github.Response, error) {
+	return s.do(ctx, "POST", envVariables(repoID, env), v)
+}
+
+func (s *service) UpdateEnvVariable(ctx context.Context, repoID int64, env string, v *Variable) (*github.Response, error) {
+	return s.do(ctx, "PATCH", fmt.Sprintf("%v/%v", envVariables(repoID, env), v.Name), v)
+}
+
+func (s *service) DeleteEnvVariable(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("%v/%v", envVariables(repoID, env), name), nil)
+}
+
+func (s *service) GetOrgVariable(ctx context.Context, org, name string) (*Variable, *github.Response, error) {
+	return s.get(ctx, fmt.Sprintf("%v/%v", orgVariables(org), name))
+}
+
+func (s *service) CreateOrgVariable(ctx context.Context, org string, v *Variable) (*github.Response, error) {
+	return s.do(ctx, "POST", orgVariables(org), v)
+}
+
+func (s *service) UpdateOrgVariable(ctx context.Context, org string, v *Variable) (*github.Response, error) {
+	return s.do(ctx, "PATCH", fmt.Sprintf("%v/%v", orgVariables(org), v.Name), v)
+}
+
+func (s *service) DeleteOrgVariable(ctx context.Context, org, name string) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("%v/%v", orgVariables(org), name), nil)
+}
+
+func (s *service) ListSelectedReposForOrgVariable(ctx context.Context, org, name string) ([]*github.Repository, error) {
+	return ghclient.ListSelectedRepositories(ctx, s.client, fmt.Sprintf("%v/%v/repositories", orgVariables(org), name))
+}
+
+// GetValue returns the value of a variable, which is either given inline or
+// by the key of a ConfigMap.
+func GetValue(ctx context.Context, c client.Reader, value *string, ref *v1alpha1.ConfigMapKeySelector) (string, error) {
+	switch {
+	case value != nil:
+		return *value, nil
+	case ref != nil:
+		return ghclient.GetConfigMapValue(ctx, c, ref.Namespace, ref.Name, ref.Key)
+	default:
+		return "", errors.New(errNoValue)
+	}
+}
+
+// GenerateOrgVariable produces Variable object from
+// OrganizationActionsVariableParameters object and the value of the variable.
+func GenerateOrgVariable(p orgsv1alpha1.OrganizationActionsVariableParameters, value string) *Variable {
+	v := &Variable{
+		Name:       p.Name,
+		Value:      value,
+		Visibility: p.Visibility,
+	}
+	if p.Visibility == orgsv1alpha1.VisibilitySelected {
+		ids := append([]int64{}, p.SelectedRepositoryIDs...)
+		v.SelectedRepositoryIDs = &ids
+	}
+	return v
+}
+
+// IsOrgVariableUpToDate checks whether the value, the visibility and the
+// selected repositories of an organization variable are the ones given in
+// OrganizationActionsVariableParameters.
+func IsOrgVariableUpToDate(p orgsv1alpha1.OrganizationActionsVariableParameters, value string, v *Variable, repos []*github.Repository) bool {
+	return value == v.Value && ghclient.IsVisibilityUpToDate(p.Visibility, p.SelectedRepositoryIDs, v.Visibility, repos)
+}
+
+// GenerateObservation produces ActionsVariableObservation object from
+// Variable object.
+func GenerateObservation(v *Variable) v1alpha1.ActionsVariableObservation {
+	return v1alpha1.ActionsVariableObservation{
+		CreatedAt: ghclient.ConvertTimestamp(v.CreatedAt),
+		UpdatedAt: ghclient.ConvertTimestamp(v.UpdatedAt),
+	}
+}
+
+// GenerateEnvObservation produces EnvironmentVariableObservation object from
+// Variable object.
+func GenerateEnvObservation(v *Variable) v1alpha1.EnvironmentVariableObservation {
+	return v1alpha1.EnvironmentVariableObservation{
+		CreatedAt: ghclient.ConvertTimestamp(v.CreatedAt),
+		UpdatedAt: ghclient.ConvertTimestamp(v.UpdatedAt),
+	}
+}
+
+// GenerateOrgObservation produces OrganizationActionsVariableObservation
+// object from Variable object and the repositories that can access it.
+func GenerateOrgObservation(v *Variable, repos []*github.Repository) orgsv1alpha1.OrganizationActionsVariableObservation {
+	return orgsv1alpha1.OrganizationActionsVariableObservation{
+		Visibility:           v.Visibility,
+		SelectedRepositories: ghclient.SelectedRepositoryNames(repos),
+		CreatedAt:            ghclient.ConvertTimestamp(v.CreatedAt),
+		UpdatedAt:            ghclient.ConvertTimestamp(v.UpdatedAt),
+	}
+}
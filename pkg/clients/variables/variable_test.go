@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	orgsv1alpha1 "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+func TestGetValue(t *testing.T) {
+	ref := &v1alpha1.ConfigMapKeySelector{Name: "registry", Namespace: "crossplane-system", Key: "host"}
+
+	type args struct {
+		kube  client.Reader
+		value *string
+		ref   *v1alpha1.ConfigMapKeySelector
+	}
+	type want struct {
+		value string
+		err   error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Inline": {
+			args: args{
+				value: github.String("registry.example.org"),
+				ref:   ref,
+			},
+			want: want{
+				value: "registry.example.org",
+			},
+		},
+		"ConfigMap": {
+			args: args{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"host": "mirror.example.org"}
+					return nil
+				}},
+				ref: ref,
+			},
+			want: want{
+				value: "mirror.example.org",
+			},
+		},
+		"MissingKey": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				ref:  ref,
+			},
+			want: want{
+				err: errors.New("ConfigMap does not have the referenced key"),
+			},
+		},
+		"NoValue": {
+			want: want{
+				err: errors.New(errNoValue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetValue(context.Background(), tc.args.kube, tc.args.value, tc.args.ref)
+			if diff := cmp.Diff(tc.want.value, got); diff != "" {
+				t.Errorf("\nGetValue(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nGetValue(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateOrgVariable(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      orgsv1alpha1.OrganizationActionsVariableParameters
+		want   string
+	}{
+		"Private": {
+			reason: "Must not send selected repositories if the visibility is not selected",
+			p:      orgsv1alpha1.OrganizationActionsVariableParameters{Name: "ENV", Visibility: "private"},
+			want:   `{"name":"ENV","value":"v","visibility":"private"}`,
+		},
+		"Selected": {
+			reason: "Must send the selected repositories",
+			p:      orgsv1alpha1.OrganizationActionsVariableParameters{Name: "ENV", Visibility: "selected", SelectedRepositoryIDs: []int64{1, 2}},
+			want:   `{"name":"ENV","value":"v","visibility":"selected","selected_repository_ids":[1,2]}`,
+		},
+		"NoneSelected": {
+			reason: "Must send an empty selection, GitHub keeps the previous one otherwise",
+			p:      orgsv1alpha1.OrganizationActionsVariableParameters{Name: "ENV", Visibility: "selected"},
+			want:   `{"name":"ENV","value":"v","visibility":"selected","selected_repository_ids":[]}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := json.Marshal(GenerateOrgVariable(tc.p, "v"))
+			if err != nil {
+				t.Fatalf("json.Marshal(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("\n%s\nGenerateOrgVariable(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsOrgVariableUpToDate(t *testing.T) {
+	repos := []*github.Repository{{ID: github.Int64(1)}, {ID: github.Int64(2)}}
+
+	type args struct {
+		p     orgsv1alpha1.OrganizationActionsVariableParameters
+		value string
+		v     *Variable
+		repos []*github.Repository
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p:     orgsv1alpha1.OrganizationActionsVariableParameters{Visibility: "selected", SelectedRepositoryIDs: []int64{2, 1}},
+				value: "v",
+				v:     &Variable{Value: "v", Visibility: "selected"},
+				repos: repos,
+			},
+			want: true,
+		},
+		"ValueChanged": {
+			args: args{
+				p:     orgsv1alpha1.OrganizationActionsVariableParameters{Visibility: "all"},
+				value: "w",
+				v:     &Variable{Value: "v", Visibility: "all"},
+			},
+			want: false,
+		},
+		"VisibilityChanged": {
+			args: args{
+				p:     orgsv1alpha1.OrganizationActionsVariableParameters{Visibility: "private"},
+				value: "v",
+				v:     &Variable{Value: "v", Visibility: "all"},
+			},
+			want: false,
+		},
+		"RepositoryDeselected": {
+			args: args{
+				p:     orgsv1alpha1.OrganizationActionsVariableParameters{Visibility: "selected", SelectedRepositoryIDs: []int64{1}},
+				value: "v",
+				v:     &Variable{Value: "v", Visibility: "selected"},
+				repos: repos,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOrgVariableUpToDate(tc.args.p, tc.args.value, tc.args.v, tc.args.repos)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nIsOrgVariableUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
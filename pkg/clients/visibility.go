@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-github/v33/github"
+
+	orgsv1alpha1 "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+)
+
+// SetSecretVisibility sets the supplied visibility and, if it is selected,
+// the supplied repository IDs on an organization secret.
+func SetSecretVisibility(s *github.EncryptedSecret, visibility string, ids []int64) {
+	s.Visibility = visibility
+	if visibility == orgsv1alpha1.VisibilitySelected {
+		s.SelectedRepositoryIDs = github.SelectedRepoIDs(ids)
+	}
+}
+
+// IsVisibilityUpToDate checks whether the observed visibility of an
+// organization secret or variable is the supplied one and, if it is
+// selected, whether it can be accessed by the repositories with the supplied
+// IDs.
+func IsVisibilityUpToDate(visibility string, ids []int64, observed string, repos []*github.Repository) bool {
+	if visibility != observed {
+		return false
+	}
+	if visibility != orgsv1alpha1.VisibilitySelected {
+		return true
+	}
+	return AreSelectedRepositoriesUpToDate(ids, repos)
+}
+
+// AreSelectedRepositoriesUpToDate checks whether the supplied repositories are
+// the ones with the supplied IDs, in any order. Organization secrets and
+// variables can be accessed by the repositories selected this way.
+func AreSelectedRepositoriesUpToDate(ids []int64, repos []*github.Repository) bool {
+	if len(ids) != len(repos) {
+		return false
+	}
+	selected := make(map[int64]bool, len(repos))
+	for _, r := range repos {
+		selected[r.GetID()] = true
+	}
+	for _, id := range ids {
+		if !selected[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// SelectedRepositoryNames returns the sorted names of the supplied
+// repositories.
+func SelectedRepositoryNames(repos []*github.Repository) []string {
+	var names []string
+	for _, r := range repos {
+		names = append(names, r.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ListSelectedRepositories returns all repositories that can access an
+// organization secret or variable, listed at the supplied path. It is sent as
+// a raw request, go-github does not support paginating them yet.
+func ListSelectedRepositories(ctx context.Context, c *github.Client, path string) ([]*github.Repository, error) {
+	var repos []*github.Repository
+	page := 1
+	for {
+		req, err := c.NewRequest("GET", fmt.Sprintf("%v?per_page=100&page=%d", path, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		l := &github.SelectedReposList{}
+		res, err := c.Do(ctx, req, l)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, l.Repositories...)
+		if res.NextPage == 0 {
+			return repos, nil
+		}
+		page = res.NextPage
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+)
+
+func TestListSelectedRepositories(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/crossplane/actions/secrets/TOKEN/repositories" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// One repository per page, the first page links to the second.
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%v%v?page=2>; rel="next"`, r.Host, r.URL.Path))
+			_, _ = w.Write([]byte(`{"total_count": 2, "repositories": [{"id": 1}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"total_count": 2, "repositories": [{"id": 2}]}`))
+	}))
+	defer srv.Close()
+
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	repos, err := ListSelectedRepositories(context.Background(), gh, "orgs/crossplane/actions/secrets/TOKEN/repositories")
+	if err != nil {
+		t.Fatalf("ListSelectedRepositories(...): %s", err)
+	}
+	var ids []int64
+	for _, r := range repos {
+		ids = append(ids, r.GetID())
+	}
+	if diff := cmp.Diff([]int64{1, 2}, ids); diff != "" {
+		t.Errorf("ListSelectedRepositories(...): -want IDs, +got IDs:\n%s", diff)
+	}
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/go-github/v33/github"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	organizations "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+)
+
+// Keys of the configuration of a webhook.
+const (
+	configURL         = "url"
+	configContentType = "content_type"
+	configSecret      = "secret"
+	configInsecureSSL = "insecure_ssl"
+)
+
+// A Hook is a GitHub webhook. Its ping and test URLs, and the response to its
+// last delivery, are not supported by go-github yet.
+type Hook struct {
+	github.Hook
+	TestURL      *string       `json:"test_url,omitempty"`
+	PingURL      *string       `json:"ping_url,omitempty"`
+	LastResponse *HookResponse `json:"last_response,omitempty"`
+}
+
+// A HookResponse is the response to the last delivery of a Hook.
+type HookResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// A HookDelivery is a delivery of the payload of an event to a Hook.
+// Deliveries are not supported by go-github yet.
+type HookDelivery struct {
+	ID          *int64            `json:"id,omitempty"`
+	GUID        *string           `json:"guid,omitempty"`
+	DeliveredAt *github.Timestamp `json:"delivered_at,omitempty"`
+	Redelivery  *bool             `json:"redelivery,omitempty"`
+	Status      *string           `json:"status,omitempty"`
+	StatusCode  *int              `json:"status_code,omitempty"`
+	Event       *string           `json:"event,omitempty"`
+	Action      *string           `json:"action,omitempty"`
+}
+
+// Service defines the Repository and organization webhook operations
+type Service interface {
+	GetHook(ctx context.Context, owner, repo string, id int64) (*Hook, *github.Response, error)
+	CreateHook(ctx context.Context, owner, repo string, hook *github.Hook) (*Hook, *github.Response, error)
+	EditHook(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*Hook, *github.Response, error)
+	DeleteHook(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
+	GetOrganizationHook(ctx context.Context, org string, id int64) (*Hook, *github.Response, error)
+	CreateOrganizationHook(ctx context.Context, org string, hook *github.Hook) (*Hook, *github.Response, error)
+	EditOrganizationHook(ctx context.Context, org string, id int64, hook *github.Hook) (*Hook, *github.Response, error)
+	DeleteOrganizationHook(ctx context.Context, org string, id int64) (*github.Response, error)
+	ListOrganizationHookDeliveries(ctx context.Context, org string, id int64, opts *github.ListOptions) ([]*HookDelivery, *github.Response, error)
+}
+
+// NewService creates a new Service based on the *github.Client
+// returned by the GetClient SDK method.
+func NewService(cfg ghclient.Config) (*Service, error) {
+	c, err := ghclient.GetClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service(&service{client: c})
+	return &s, nil
+}
+
+type service struct {
+	client *github.Client
+}
+
+func (s *service) GetHook(ctx context.Context, owner, repo string, id int64) (*Hook, *github.Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("repos/%v/%v/hooks/%v", owner, repo, id), nil)
+}
+
+func (s *service) CreateHook(ctx context.Context, owner, repo string, hook *github.Hook) (*Hook, *github.Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("repos/%v/%v/hooks", owner, repo), hook)
+}
+
+func (s *service) EditHook(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*Hook, *github.Response, error) {
+	return s.do(ctx, "PATCH", fmt.Sprintf("repos/%v/%v/hooks/%v", owner, repo, id), hook)
+}
+
+func (s *service) DeleteHook(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+	return s.client.Repositories.DeleteHook(ctx, owner, repo, id)
+}
+
+func (s *service) GetOrganizationHook(ctx context.Context, org string, id int64) (*Hook, *github.Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("orgs/%v/hooks/%v", org, id), nil)
+}
+
+func (s *service) CreateOrganizationHook(ctx context.Context, org string, hook *github.Hook) (*Hook, *github.Response, error) {
+	// Organizations only have web hooks, whose name must be given.
+	return s.do(ctx, "POST", fmt.Sprintf("orgs/%v/hooks", org), &organizationHook{Hook: hook, Name: "web"})
+}
+
+func (s *service) EditOrganizationHook(ctx context.Context, org string, id int64, hook *github.Hook) (*Hook, *github.Response, error) {
+	return s.do(ctx, "PATCH", fmt.Sprintf("orgs/%v/hooks/%v", org, id), hook)
+}
+
+func (s *service) DeleteOrganizationHook(ctx context.Context, org string, id int64) (*github.Response, error) {
+	return s.client.Organizations.DeleteHook(ctx, org, id)
+}
+
+func (s *service) ListOrganizationHookDeliveries(ctx context.Context, org string, id int64, opts *github.ListOptions) ([]*HookDelivery, *github.Response, error) {
+	q := url.Values{}
+	if opts != nil && opts.PerPage != 0 {
+		q.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	u := url.URL{Path: fmt.Sprintf("orgs/%v/hooks/%v/deliveries", org, id), RawQuery: q.Encode()}
+	req, err := s.client.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deliveries []*HookDelivery
+	res, err := s.client.Do(ctx, req, &deliveries)
+	if err != nil {
+		return nil, res, err
+	}
+	return deliveries, res, nil
+}
+
+type organizationHook struct {
+	*github.Hook
+	Name string `json:"name"`
+}
+
+func (s *service) do(ctx context.Context, method, u string, body interface{}) (*Hook, *github.Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	h := &Hook{}
+	res, err := s.client.Do(ctx, req, h)
+	if err != nil {
+		return nil, res, err
+	}
+	return h, res, nil
+}
+
+// GetSecret returns the secret of a webhook from the key of the Secret the
+// supplied selector refers to, and the version of the key it was read from.
+// It returns nil and an empty version if the selector is nil.
+func GetSecret(ctx context.Context, c client.Reader, ref *xpv1.SecretKeySelector) (*string, string, error) {
+	if ref == nil {
+		return nil, "", nil
+	}
+	v, err := ghclient.GetSecretValue(ctx, c, *ref)
+	if err != nil {
+		return nil, "", err
+	}
+	return github.String(string(v.Value)), v.Version, nil
+}
+
+// GenerateHook produces github.Hook from the WebhookParameters of a
+// Repository or organization webhook and the secret read from the Secret they
+// refer to. The configuration of the webhook is replaced as a whole when it is
+// edited, so the secret is always sent.
+func GenerateHook(p v1alpha1.WebhookParameters, secret *string) *github.Hook {
+	return &github.Hook{
+		Config: generateConfig(p, secret),
+		Events: p.Events,
+		Active: p.Active,
+	}
+}
+
+// IsUpToDate checks whether the observed Hook is up to date with the
+// WebhookParameters of a Repository or organization webhook. GitHub masks the
+// secret of a webhook, so only whether it has one is compared.
+func IsUpToDate(p v1alpha1.WebhookParameters, h *Hook) bool {
+	return isConfigUpToDate(p, h.Config) &&
+		areEventsUpToDate(p.Events, h.Events) &&
+		(p.Active == nil || *p.Active == h.GetActive())
+}
+
+// LateInitialize fills the empty fields of the WebhookParameters of a
+// Repository or organization webhook if the corresponding fields are given in
+// Hook.
+func LateInitialize(p *v1alpha1.WebhookParameters, h *Hook) {
+	if p.ContentType == nil {
+		if v := configValue(h.Config, configContentType); v != "" {
+			p.ContentType = ghclient.StringPtr(v)
+		}
+	}
+	if p.InsecureSSL == nil {
+		if v := configValue(h.Config, configInsecureSSL); v != "" {
+			p.InsecureSSL = github.Bool(v == "1")
+		}
+	}
+	if p.Events == nil && len(h.Events) > 0 {
+		p.Events = h.Events
+	}
+	if p.Active == nil && h.Active != nil {
+		p.Active = github.Bool(*h.Active)
+	}
+}
+
+// GenerateObservation produces RepositoryWebhookObservation object from Hook
+// object.
+func GenerateObservation(h *Hook) v1alpha1.RepositoryWebhookObservation {
+	o := v1alpha1.RepositoryWebhookObservation{
+		ID:        h.GetID(),
+		URL:       h.GetURL(),
+		PingURL:   ghclient.StringValue(h.PingURL),
+		TestURL:   ghclient.StringValue(h.TestURL),
+		CreatedAt: convertTime(h.CreatedAt),
+		UpdatedAt: convertTime(h.UpdatedAt),
+	}
+	if r := h.LastResponse; r != nil {
+		o.LastResponseCode = ghclient.IntValue(r.Code)
+		o.LastResponseStatus = ghclient.StringValue(r.Status)
+	}
+	return o
+}
+
+// LastOrganizationHookDelivery returns the most recent delivery to the
+// organization webhook with the supplied ID, or nil if there is none.
+func LastOrganizationHookDelivery(ctx context.Context, s Service, org string, id int64) (*HookDelivery, error) {
+	// Deliveries are returned from the most recent one.
+	deliveries, _, err := s.ListOrganizationHookDeliveries(ctx, org, id, &github.ListOptions{PerPage: 1})
+	if err != nil || len(deliveries) == 0 {
+		return nil, err
+	}
+	return deliveries[0], nil
+}
+
+// GenerateOrganizationHookObservation produces OrganizationWebhookObservation
+// object from Hook object and its last HookDelivery, if any.
+func GenerateOrganizationHookObservation(h *Hook, d *HookDelivery) organizations.OrganizationWebhookObservation {
+	o := organizations.OrganizationWebhookObservation{
+		ID:        h.GetID(),
+		URL:       h.GetURL(),
+		PingURL:   ghclient.StringValue(h.PingURL),
+		CreatedAt: convertTime(h.CreatedAt),
+		UpdatedAt: convertTime(h.UpdatedAt),
+	}
+	if r := h.LastResponse; r != nil {
+		o.LastResponseCode = ghclient.IntValue(r.Code)
+		o.LastResponseStatus = ghclient.StringValue(r.Status)
+	}
+	if d != nil {
+		o.LastDelivery = &organizations.WebhookDelivery{
+			ID:          ghclient.Int64Value(d.ID),
+			GUID:        ghclient.StringValue(d.GUID),
+			DeliveredAt: ghclient.ConvertTimestamp(d.DeliveredAt),
+			Redelivery:  ghclient.BoolValue(d.Redelivery),
+			Status:      ghclient.StringValue(d.Status),
+			StatusCode:  ghclient.IntValue(d.StatusCode),
+			Event:       ghclient.StringValue(d.Event),
+			Action:      ghclient.StringValue(d.Action),
+		}
+	}
+	return o
+}
+
+func generateConfig(p v1alpha1.WebhookParameters, secret *string) map[string]interface{} {
+	c := map[string]interface{}{configURL: p.URL}
+	if p.ContentType != nil {
+		c[configContentType] = *p.ContentType
+	}
+	if p.InsecureSSL != nil {
+		c[configInsecureSSL] = "0"
+		if *p.InsecureSSL {
+			c[configInsecureSSL] = "1"
+		}
+	}
+	if secret != nil {
+		c[configSecret] = *secret
+	}
+	return c
+}
+
+func isConfigUpToDate(p v1alpha1.WebhookParameters, c map[string]interface{}) bool {
+	if configValue(c, configURL) != p.URL {
+		return false
+	}
+	if p.ContentType != nil && configValue(c, configContentType) != *p.ContentType {
+		return false
+	}
+	if p.InsecureSSL != nil && (configValue(c, configInsecureSSL) == "1") != *p.InsecureSSL {
+		return false
+	}
+	return (configValue(c, configSecret) != "") == (p.SecretRef != nil)
+}
+
+func areEventsUpToDate(desired, observed []string) bool {
+	if desired == nil {
+		return true
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// configValue returns the value of the supplied key of the configuration of a
+// webhook as a string. GitHub returns insecure_ssl as a string, but accepts
+// and may return it as a number.
+func configValue(c map[string]interface{}, key string) string {
+	v, ok := c[key]
+	if !ok || v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func convertTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	return &metav1.Time{Time: *t}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	organizations "github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+)
+
+var fakeURL = "https://ci.example.org/hooks/github"
+
+func hook() *Hook {
+	return &Hook{
+		Hook: github.Hook{
+			ID: github.Int64(42),
+			Config: map[string]interface{}{
+				"url":          fakeURL,
+				"content_type": "json",
+				"insecure_ssl": "0",
+				"secret":       "********",
+			},
+			Events: []string{"push", "pull_request"},
+			Active: github.Bool(true),
+		},
+		PingURL:      github.String("https://api.github.com/repos/crossplane/sample/hooks/42/pings"),
+		LastResponse: &HookResponse{Code: github.Int(200), Status: github.String("active")},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	secretRef := &xpv1.SecretKeySelector{Key: "secret"}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.WebhookParameters
+		want   bool
+	}{
+		"NothingGiven": {
+			reason: "Settings that are not given must not be compared",
+			p:      v1alpha1.WebhookParameters{URL: fakeURL, SecretRef: secretRef},
+			want:   true,
+		},
+		"UpToDate": {
+			reason: "Must return true if the given settings match regardless of the order of events",
+			p: v1alpha1.WebhookParameters{
+				URL:         fakeURL,
+				ContentType: github.String("json"),
+				SecretRef:   secretRef,
+				InsecureSSL: github.Bool(false),
+				Events:      []string{"pull_request", "push"},
+				Active:      github.Bool(true),
+			},
+			want: true,
+		},
+		"URLChanged": {
+			reason: "Must return false if the URL changed",
+			p:      v1alpha1.WebhookParameters{URL: "https://example.org", SecretRef: secretRef},
+			want:   false,
+		},
+		"InsecureSSLChanged": {
+			reason: "Must return false if SSL verification is disabled but enabled",
+			p:      v1alpha1.WebhookParameters{URL: fakeURL, SecretRef: secretRef, InsecureSSL: github.Bool(true)},
+			want:   false,
+		},
+		"EventsChanged": {
+			reason: "Must return false if the events changed",
+			p:      v1alpha1.WebhookParameters{URL: fakeURL, SecretRef: secretRef, Events: []string{"push"}},
+			want:   false,
+		},
+		"SecretRemoved": {
+			reason: "Must return false if the webhook has a secret that is not given",
+			p:      v1alpha1.WebhookParameters{URL: fakeURL},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, hook())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateHook(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.WebhookParameters
+		secret *string
+		want   *github.Hook
+	}{
+		"Full": {
+			reason: "Must produce the configuration including the secret",
+			p: v1alpha1.WebhookParameters{
+				URL:         fakeURL,
+				ContentType: github.String("json"),
+				InsecureSSL: github.Bool(true),
+				Events:      []string{"push"},
+				Active:      github.Bool(false),
+			},
+			secret: github.String("s3cr3t"),
+			want: &github.Hook{
+				Config: map[string]interface{}{
+					"url":          fakeURL,
+					"content_type": "json",
+					"insecure_ssl": "1",
+					"secret":       "s3cr3t",
+				},
+				Events: []string{"push"},
+				Active: github.Bool(false),
+			},
+		},
+		"NoSecret": {
+			reason: "Must leave out settings that are not given",
+			p:      v1alpha1.WebhookParameters{URL: fakeURL},
+			want: &github.Hook{
+				Config: map[string]interface{}{"url": fakeURL},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateHook(tc.p, tc.secret)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateHook(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.WebhookParameters
+		want   v1alpha1.WebhookParameters
+	}{
+		"Empty": {
+			reason: "Must fill the settings that are not given",
+			p:      v1alpha1.WebhookParameters{URL: fakeURL},
+			want: v1alpha1.WebhookParameters{
+				URL:         fakeURL,
+				ContentType: github.String("json"),
+				InsecureSSL: github.Bool(false),
+				Events:      []string{"push", "pull_request"},
+				Active:      github.Bool(true),
+			},
+		},
+		"Given": {
+			reason: "Must not overwrite given settings",
+			p: v1alpha1.WebhookParameters{
+				URL:         fakeURL,
+				ContentType: github.String("form"),
+				InsecureSSL: github.Bool(true),
+				Events:      []string{"push"},
+				Active:      github.Bool(false),
+			},
+			want: v1alpha1.WebhookParameters{
+				URL:         fakeURL,
+				ContentType: github.String("form"),
+				InsecureSSL: github.Bool(true),
+				Events:      []string{"push"},
+				Active:      github.Bool(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.p, hook())
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("\n%s\nLateInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	want := v1alpha1.RepositoryWebhookObservation{
+		ID:                 42,
+		PingURL:            "https://api.github.com/repos/crossplane/sample/hooks/42/pings",
+		LastResponseCode:   200,
+		LastResponseStatus: "active",
+	}
+	got := GenerateObservation(hook())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nGenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+type mockService struct {
+	Service
+	deliveries []*HookDelivery
+	opts       *github.ListOptions
+}
+
+func (m *mockService) ListOrganizationHookDeliveries(_ context.Context, _ string, _ int64, opts *github.ListOptions) ([]*HookDelivery, *github.Response, error) {
+	m.opts = opts
+	return m.deliveries, nil, nil
+}
+
+func TestLastOrganizationHookDelivery(t *testing.T) {
+	last := &HookDelivery{ID: github.Int64(8), Redelivery: github.Bool(true)}
+
+	cases := map[string]struct {
+		reason     string
+		deliveries []*HookDelivery
+		want       *HookDelivery
+	}{
+		"NoDeliveries": {
+			reason: "Must return nil if nothing was delivered to the webhook yet",
+		},
+		"Delivered": {
+			reason:     "Must return the most recent delivery",
+			deliveries: []*HookDelivery{last},
+			want:       last,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &mockService{deliveries: tc.deliveries}
+			got, err := LastOrganizationHookDelivery(context.Background(), s, "crossplane", 42)
+			if err != nil {
+				t.Fatalf("\n%s\nLastOrganizationHookDelivery(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nLastOrganizationHookDelivery(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(&github.ListOptions{PerPage: 1}, s.opts); diff != "" {
+				t.Errorf("\n%s\nLastOrganizationHookDelivery(...): -want options, +got options:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateOrganizationHookObservation(t *testing.T) {
+	deliveredAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	d := &HookDelivery{
+		ID:          github.Int64(8),
+		GUID:        github.String("0b989ba4-242f-11e5-81e1-c7b6966d2516"),
+		DeliveredAt: &github.Timestamp{Time: deliveredAt},
+		Redelivery:  github.Bool(true),
+		Status:      github.String("OK"),
+		StatusCode:  github.Int(200),
+		Event:       github.String("issues"),
+		Action:      github.String("opened"),
+	}
+	want := organizations.OrganizationWebhookObservation{
+		ID:                 42,
+		PingURL:            "https://api.github.com/repos/crossplane/sample/hooks/42/pings",
+		LastResponseCode:   200,
+		LastResponseStatus: "active",
+		LastDelivery: &organizations.WebhookDelivery{
+			ID:          8,
+			GUID:        "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+			DeliveredAt: &metav1.Time{Time: deliveredAt},
+			Redelivery:  true,
+			Status:      "OK",
+			StatusCode:  200,
+			Event:       "issues",
+			Action:      "opened",
+		},
+	}
+	got := GenerateOrganizationHookObservation(hook(), d)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\nGenerateOrganizationHookObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -33,7 +33,32 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error{
 		config.Setup,
 		organizations.SetupMembership,
+		organizations.SetupTeam,
+		organizations.SetupTeamMembership,
+		organizations.SetupTeamRepository,
+		organizations.SetupOrganizationRuleset,
+		organizations.SetupOrganizationWebhook,
+		organizations.SetupOrganizationActionsSecret,
+		organizations.SetupOrganizationActionsVariable,
+		organizations.SetupOrganizationDependabotSecret,
+		organizations.SetupOrganizationCodespacesSecret,
 		repositories.SetupRepository,
+		repositories.SetupRepositoryCollaborator,
+		repositories.SetupBranchProtection,
+		repositories.SetupRepositoryRuleset,
+		repositories.SetupRepositoryWebhook,
+		repositories.SetupDeployKey,
+		repositories.SetupEnvironment,
+		repositories.SetupActionsSecret,
+		repositories.SetupActionsVariable,
+		repositories.SetupEnvironmentVariable,
+		repositories.SetupEnvironmentSecret,
+		repositories.SetupDependabotSecret,
+		repositories.SetupCodespacesSecret,
+		repositories.SetupLabel,
+		repositories.SetupLabelSet,
+		repositories.SetupRepositoryFile,
+		repositories.SetupBranch,
 	} {
 		if err := setup(mgr, l, rl); err != nil {
 			return err
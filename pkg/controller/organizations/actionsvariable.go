@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/variables"
+)
+
+const (
+	errUnexpectedActionsVariable       = "The managed resource is not an OrganizationActionsVariable resource"
+	errGetActionsVariableValue         = "cannot get value of OrganizationActionsVariable"
+	errGetActionsVariable              = "cannot get OrganizationActionsVariable"
+	errGetVariableSelectedRepositories = "cannot get selected repositories of OrganizationActionsVariable"
+	errCreateActionsVariable           = "cannot create OrganizationActionsVariable"
+	errUpdateActionsVariable           = "cannot update OrganizationActionsVariable"
+	errDeleteActionsVariable           = "cannot delete OrganizationActionsVariable"
+)
+
+// SetupOrganizationActionsVariable adds a controller that reconciles
+// OrganizationActionsVariables.
+func SetupOrganizationActionsVariable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.OrganizationActionsVariableGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.OrganizationActionsVariable{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OrganizationActionsVariableGroupVersionKind),
+			managed.WithExternalConnecter(&actionsVariableConnector{client: mgr.GetClient(), newClientFn: variables.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type actionsVariableConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*variables.Service, error)
+}
+
+func (c *actionsVariableConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationActionsVariable)
+	if !ok {
+		return nil, errors.New(errUnexpectedActionsVariable)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &actionsVariableExternal{*gh, c.client}, nil
+}
+
+type actionsVariableExternal struct {
+	gh     variables.Service
+	client client.Client
+}
+
+func (e *actionsVariableExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationActionsVariable)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedActionsVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	v, _, err := e.gh.GetOrgVariable(ctx, p.Organization, p.Name)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetActionsVariable)
+	}
+
+	var repos []*github.Repository
+	if v.Visibility == v1alpha1.VisibilitySelected {
+		repos, err = e.gh.ListSelectedReposForOrgVariable(ctx, p.Organization, p.Name)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetVariableSelectedRepositories)
+		}
+	}
+
+	value, err := variables.GetValue(ctx, e.client, p.Value, p.ValueConfigMapRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetActionsVariableValue)
+	}
+
+	cr.Status.AtProvider = variables.GenerateOrgObservation(v, repos)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate: variables.IsOrgVariableUpToDate(p, value, v, repos),
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *actionsVariableExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationActionsVariable)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedActionsVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	value, err := variables.GetValue(ctx, e.client, p.Value, p.ValueConfigMapRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetActionsVariableValue)
+	}
+	if _, err := e.gh.CreateOrgVariable(ctx, p.Organization, variables.GenerateOrgVariable(p, value)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateActionsVariable)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *actionsVariableExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationActionsVariable)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedActionsVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	value, err := variables.GetValue(ctx, e.client, p.Value, p.ValueConfigMapRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetActionsVariableValue)
+	}
+	_, err = e.gh.UpdateOrgVariable(ctx, p.Organization, variables.GenerateOrgVariable(p, value))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateActionsVariable)
+}
+
+func (e *actionsVariableExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OrganizationActionsVariable)
+	if !ok {
+		return errors.New(errUnexpectedActionsVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.DeleteOrgVariable(ctx, p.Organization, p.Name)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteActionsVariable)
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/variables"
+	repofake "github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeVariableName  = "REGISTRY"
+	fakeVariableValue = "registry.example.org"
+)
+
+func newActionsVariable(selected ...int64) *v1alpha1.OrganizationActionsVariable {
+	r := &v1alpha1.OrganizationActionsVariable{}
+	r.Spec.ForProvider = v1alpha1.OrganizationActionsVariableParameters{
+		Organization: fakeOrg,
+		Name:         fakeVariableName,
+		Value:        &fakeVariableValue,
+		Visibility:   v1alpha1.VisibilityAll,
+	}
+	if selected != nil {
+		r.Spec.ForProvider.Visibility = v1alpha1.VisibilitySelected
+		r.Spec.ForProvider.SelectedRepositoryIDs = selected
+	}
+	return r
+}
+
+func observedActionsVariable(value, visibility string) func(ctx context.Context, org, name string) (*variables.Variable, *github.Response, error) {
+	return func(ctx context.Context, org, name string) (*variables.Variable, *github.Response, error) {
+		return &variables.Variable{Name: name, Value: value, Visibility: visibility}, nil, nil
+	}
+}
+
+type actionsVariableArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github variables.Service
+}
+
+func TestActionsVariableObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotOrganizationActionsVariable": {
+			reason: "Must return an error if the resource is not an OrganizationActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"CannotGetActionsVariable": {
+			reason: "Must return an error if GET variable fails and the error is not 404",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &repofake.MockVariableService{
+					MockGetOrgVariable: func(ctx context.Context, org, name string) (*variables.Variable, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetActionsVariable),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the variable does not exist",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &repofake.MockVariableService{
+					MockGetOrgVariable: func(ctx context.Context, org, name string) (*variables.Variable, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"CannotGetSelectedRepositories": {
+			reason: "Must return an error if the selected repositories cannot be listed",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(1),
+				github: &repofake.MockVariableService{
+					MockGetOrgVariable: observedActionsVariable(fakeVariableValue, v1alpha1.VisibilitySelected),
+					MockListSelectedReposForOrgVariable: func(ctx context.Context, org, name string) ([]*github.Repository, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetVariableSelectedRepositories),
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the value and the selected repositories did not change",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(1, 2),
+				github: &repofake.MockVariableService{
+					MockGetOrgVariable:                  observedActionsVariable(fakeVariableValue, v1alpha1.VisibilitySelected),
+					MockListSelectedReposForOrgVariable: selectedRepositories(2, 1),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RepositorySelected": {
+			reason: "Must return ResourceUpToDate as false if a repository was selected since",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(1, 2),
+				github: &repofake.MockVariableService{
+					MockGetOrgVariable:                  observedActionsVariable(fakeVariableValue, v1alpha1.VisibilitySelected),
+					MockListSelectedReposForOrgVariable: selectedRepositories(1),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ValueChanged": {
+			reason: "Must return ResourceUpToDate as false if the value of the variable changed",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &repofake.MockVariableService{
+					MockGetOrgVariable: observedActionsVariable("mirror.example.org", v1alpha1.VisibilityAll),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestActionsVariableCreate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotOrganizationActionsVariable": {
+			reason: "Must return an error if the resource is not an OrganizationActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the variable cannot be created",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &repofake.MockVariableService{
+					MockCreateOrgVariable: func(ctx context.Context, org string, v *variables.Variable) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateActionsVariable),
+			},
+		},
+		"Success": {
+			reason: "Must create the variable with the selected repositories",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(1, 2),
+				github: &repofake.MockVariableService{
+					MockCreateOrgVariable: func(ctx context.Context, org string, v *variables.Variable) (*github.Response, error) {
+						if v.Value != fakeVariableValue || !cmp.Equal(v.SelectedRepositoryIDs, &[]int64{1, 2}) {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalCreation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestActionsVariableUpdate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotOrganizationActionsVariable": {
+			reason: "Must return an error if the resource is not an OrganizationActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the variable cannot be updated",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &repofake.MockVariableService{
+					MockUpdateOrgVariable: func(ctx context.Context, org string, v *variables.Variable) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateActionsVariable),
+			},
+		},
+		"Success": {
+			reason: "Must not send selected repositories if the visibility is not selected",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &repofake.MockVariableService{
+					MockUpdateOrgVariable: func(ctx context.Context, org string, v *variables.Variable) (*github.Response, error) {
+						if v.Visibility != v1alpha1.VisibilityAll || v.SelectedRepositoryIDs != nil {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalUpdate{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestActionsVariableDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotOrganizationActionsVariable": {
+			reason: "Must return an error if the resource is not an OrganizationActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the variable was already deleted",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &repofake.MockVariableService{
+					MockDeleteOrgVariable: func(ctx context.Context, org, name string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
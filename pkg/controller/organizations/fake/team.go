@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teams"
+)
+
+// This ensures that the mock implements the Service interface
+var _ teams.Service = (*MockTeamService)(nil)
+
+// MockTeamService is a mock implementation of the teams Service
+type MockTeamService struct {
+	MockGet    func(ctx context.Context, org, slug string) (*teams.Team, *github.Response, error)
+	MockCreate func(ctx context.Context, org string, team *teams.TeamRequest) (*teams.Team, *github.Response, error)
+	MockEdit   func(ctx context.Context, org, slug string, team *teams.TeamRequest) (*teams.Team, *github.Response, error)
+	MockDelete func(ctx context.Context, org, slug string) (*github.Response, error)
+}
+
+// Get is a fake Get SDK method
+func (m *MockTeamService) Get(ctx context.Context, org, slug string) (*teams.Team, *github.Response, error) {
+	return m.MockGet(ctx, org, slug)
+}
+
+// Create is a fake Create SDK method
+func (m *MockTeamService) Create(ctx context.Context, org string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+	return m.MockCreate(ctx, org, team)
+}
+
+// Edit is a fake Edit SDK method
+func (m *MockTeamService) Edit(ctx context.Context, org, slug string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+	return m.MockEdit(ctx, org, slug, team)
+}
+
+// Delete is a fake Delete SDK method
+func (m *MockTeamService) Delete(ctx context.Context, org, slug string) (*github.Response, error) {
+	return m.MockDelete(ctx, org, slug)
+}
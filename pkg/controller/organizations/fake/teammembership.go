@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teammemberships"
+)
+
+// This ensures that the mock implements the Service interface
+var _ teammemberships.Service = (*MockTeamMembershipService)(nil)
+
+// MockTeamMembershipService is a mock implementation of the teammemberships
+// Service
+type MockTeamMembershipService struct {
+	MockGetTeamMembershipBySlug    func(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error)
+	MockAddTeamMembershipBySlug    func(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error)
+	MockRemoveTeamMembershipBySlug func(ctx context.Context, org, slug, user string) (*github.Response, error)
+}
+
+// GetTeamMembershipBySlug is a fake GetTeamMembershipBySlug SDK method
+func (m *MockTeamMembershipService) GetTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error) {
+	return m.MockGetTeamMembershipBySlug(ctx, org, slug, user)
+}
+
+// AddTeamMembershipBySlug is a fake AddTeamMembershipBySlug SDK method
+func (m *MockTeamMembershipService) AddTeamMembershipBySlug(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+	return m.MockAddTeamMembershipBySlug(ctx, org, slug, user, opts)
+}
+
+// RemoveTeamMembershipBySlug is a fake RemoveTeamMembershipBySlug SDK method
+func (m *MockTeamMembershipService) RemoveTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*github.Response, error) {
+	return m.MockRemoveTeamMembershipBySlug(ctx, org, slug, user)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teamrepositories"
+)
+
+// This ensures that the mock implements the Service interface
+var _ teamrepositories.Service = (*MockTeamRepositoryService)(nil)
+
+// MockTeamRepositoryService is a mock implementation of the teamrepositories
+// Service
+type MockTeamRepositoryService struct {
+	MockGet    func(ctx context.Context, org, slug, owner, repo string) (*teamrepositories.Repository, *github.Response, error)
+	MockAdd    func(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error)
+	MockRemove func(ctx context.Context, org, slug, owner, repo string) (*github.Response, error)
+}
+
+// Get is a fake Get SDK method
+func (m *MockTeamRepositoryService) Get(ctx context.Context, org, slug, owner, repo string) (*teamrepositories.Repository, *github.Response, error) {
+	return m.MockGet(ctx, org, slug, owner, repo)
+}
+
+// Add is a fake Add SDK method
+func (m *MockTeamRepositoryService) Add(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error) {
+	return m.MockAdd(ctx, org, slug, owner, repo, permission)
+}
+
+// Remove is a fake Remove SDK method
+func (m *MockTeamRepositoryService) Remove(ctx context.Context, org, slug, owner, repo string) (*github.Response, error) {
+	return m.MockRemove(ctx, org, slug, owner, repo)
+}
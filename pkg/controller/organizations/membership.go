@@ -23,6 +23,7 @@ import (
 
 const (
 	errUnexpectedObject = "The managed resource is not a Membership resource"
+	errNewClient        = "cannot create new GitHub client"
 )
 
 // SetupMembership adds a controller that reconciles Memberships.
@@ -37,7 +38,7 @@ func SetupMembership(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&v1alpha1.Membership{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.MembershipGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ghclient.NewClient}),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ghclient.GetClient}),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
@@ -47,7 +48,7 @@ func SetupMembership(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 
 type connector struct {
 	client      client.Client
-	newClientFn func(string) *github.Client
+	newClientFn func(ghclient.Config) (*github.Client, error)
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -59,7 +60,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(string(cfg)), c.client}, nil
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &external{gh, c.client}, nil
 }
 
 type external struct {
@@ -81,6 +86,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
+	cr.Status.AtProvider = v1alpha1.MembershipObservation{URL: m.URL, State: m.State}
 	if m.State != nil && *m.State == "active" {
 		cr.SetConditions(xpv1.Available())
 	} else {
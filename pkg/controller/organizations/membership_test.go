@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+)
+
+func newMembership() *v1alpha1.Membership {
+	r := &v1alpha1.Membership{}
+	r.Spec.ForProvider = v1alpha1.MembershipParameters{Organization: fakeOrg, User: "octocat"}
+	return r
+}
+
+// membershipServer returns a GitHub client whose membership of octocat in
+// the organization has the supplied body, or does not exist if it is empty.
+func membershipServer(body string) (*github.Client, func()) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/crossplane/memberships/octocat" || body == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	gh := github.NewClient(nil)
+	gh.BaseURL, _ = url.Parse(srv.URL + "/")
+	return gh, srv.Close
+}
+
+func TestMembershipObserve(t *testing.T) {
+	membershipURL := "https://api.github.com/orgs/crossplane/memberships/octocat"
+
+	type want struct {
+		eo        managed.ExternalObservation
+		o         v1alpha1.MembershipObservation
+		condition xpv1.Condition
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		body   string
+		want   want
+	}{
+		"NotFound": {
+			reason: "Must not return an error if the user is not a member",
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"Pending": {
+			reason: "Must observe the URL and the state of a pending membership",
+			body:   `{"url":"` + membershipURL + `","state":"pending"}`,
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				o:         v1alpha1.MembershipObservation{URL: &membershipURL, State: github.String("pending")},
+				condition: xpv1.Creating(),
+			},
+		},
+		"Active": {
+			reason: "Must observe the URL and the state of an active membership",
+			body:   `{"url":"` + membershipURL + `","state":"active"}`,
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				o:         v1alpha1.MembershipObservation{URL: &membershipURL, State: github.String("active")},
+				condition: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh, done := membershipServer(tc.body)
+			defer done()
+
+			cr := newMembership()
+			e := external{client: gh}
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+			}
+			if tc.want.condition.Type != "" {
+				if diff := cmp.Diff(tc.want.condition, cr.GetCondition(tc.want.condition.Type), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/rulesets"
+)
+
+const (
+	errUnexpectedRuleset = "The managed resource is not an OrganizationRuleset resource"
+	errRulesetID         = "cannot parse the ID of OrganizationRuleset from its external name"
+	errGetRuleset        = "cannot get OrganizationRuleset"
+	errCreateRuleset     = "cannot create OrganizationRuleset"
+	errUpdateRuleset     = "cannot update OrganizationRuleset"
+	errDeleteRuleset     = "cannot delete OrganizationRuleset"
+	errKubeUpdateRuleset = "cannot update OrganizationRuleset custom resource"
+)
+
+// SetupOrganizationRuleset adds a controller that reconciles
+// OrganizationRulesets.
+func SetupOrganizationRuleset(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.OrganizationRulesetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.OrganizationRuleset{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OrganizationRulesetGroupVersionKind),
+			managed.WithExternalConnecter(&rulesetConnector{client: mgr.GetClient(), newClientFn: rulesets.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type rulesetConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*rulesets.Service, error)
+}
+
+func (c *rulesetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return nil, errors.New(errUnexpectedRuleset)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &rulesetExternal{*gh, c.client}, nil
+}
+
+type rulesetExternal struct {
+	gh     rulesets.Service
+	client client.Client
+}
+
+func (e *rulesetExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedRuleset)
+	}
+
+	// The external name is the ID GitHub assigns to the ruleset when it is
+	// created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRulesetID)
+	}
+
+	p := cr.Spec.ForProvider
+	rs, _, err := e.gh.GetOrganizationRuleset(ctx, p.Organization, id)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRuleset)
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	rulesets.LateInitializeOrganizationRuleset(&cr.Spec.ForProvider, rs)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateRuleset)
+		}
+		lateInit = true
+	}
+
+	cr.Status.AtProvider = rulesets.GenerateOrganizationRulesetObservation(rs)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate:        rulesets.IsOrganizationRulesetUpToDate(cr.Spec.ForProvider, rs),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *rulesetExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedRuleset)
+	}
+
+	p := cr.Spec.ForProvider
+	rs, _, err := e.gh.CreateOrganizationRuleset(ctx, p.Organization, rulesets.GenerateOrganizationRuleset(p))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRuleset)
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(ghclient.Int64Value(rs.ID), 10))
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *rulesetExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedRuleset)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRulesetID)
+	}
+
+	p := cr.Spec.ForProvider
+	_, _, err = e.gh.UpdateOrganizationRuleset(ctx, p.Organization, id, rulesets.GenerateOrganizationRuleset(p))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRuleset)
+}
+
+func (e *rulesetExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return errors.New(errUnexpectedRuleset)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, errRulesetID)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err = e.gh.DeleteOrganizationRuleset(ctx, p.Organization, id)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteRuleset)
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+)
+
+// The errors of the organization secrets of all the secret stores, formatted
+// with the kind of the managed resource.
+const (
+	errUnexpectedSecret        = "The managed resource is not of kind %s"
+	errGetSecretValue          = "cannot get value of %s"
+	errGetPublicKey            = "cannot get public key of organization to encrypt %s"
+	errEncryptSecret           = "cannot encrypt %s"
+	errGetSecret               = "cannot get %s"
+	errGetSelectedRepositories = "cannot get selected repositories of %s"
+	errCreateSecret            = "cannot create %s"
+	errUpdateSecret            = "cannot update %s"
+	errDeleteSecret            = "cannot delete %s"
+	errKubeUpdateSecret        = "cannot update %s custom resource"
+)
+
+// orgSecretParameters are the parameters of the organization secrets of all
+// the secret stores, which only differ by the name of their type.
+type orgSecretParameters v1alpha1.OrganizationActionsSecretParameters
+
+// An orgSecretKind is the managed resource of the secrets of one secret store
+// of an organization.
+type orgSecretKind struct {
+	store     string
+	kind      string
+	groupKind string
+	gvk       schema.GroupVersionKind
+	object    func() resource.Managed
+
+	// parameters returns the parameters of the managed resource, or false
+	// if it is not of this kind.
+	parameters func(mg resource.Managed) (*orgSecretParameters, bool)
+
+	// observe sets the observation of the managed resource.
+	observe func(mg resource.Managed, s *github.Secret, repos []*github.Repository)
+}
+
+var (
+	actionsSecretKind = orgSecretKind{
+		store:     secretstores.StoreActions,
+		kind:      v1alpha1.OrganizationActionsSecretKind,
+		groupKind: v1alpha1.OrganizationActionsSecretGroupKind,
+		gvk:       v1alpha1.OrganizationActionsSecretGroupVersionKind,
+		object:    func() resource.Managed { return &v1alpha1.OrganizationActionsSecret{} },
+		parameters: func(mg resource.Managed) (*orgSecretParameters, bool) {
+			cr, ok := mg.(*v1alpha1.OrganizationActionsSecret)
+			if !ok {
+				return nil, false
+			}
+			return (*orgSecretParameters)(&cr.Spec.ForProvider), true
+		},
+		observe: func(mg resource.Managed, s *github.Secret, repos []*github.Repository) {
+			mg.(*v1alpha1.OrganizationActionsSecret).Status.AtProvider = secretstores.GenerateOrgObservation(s, repos)
+		},
+	}
+
+	dependabotSecretKind = orgSecretKind{
+		store:     secretstores.StoreDependabot,
+		kind:      v1alpha1.OrganizationDependabotSecretKind,
+		groupKind: v1alpha1.OrganizationDependabotSecretGroupKind,
+		gvk:       v1alpha1.OrganizationDependabotSecretGroupVersionKind,
+		object:    func() resource.Managed { return &v1alpha1.OrganizationDependabotSecret{} },
+		parameters: func(mg resource.Managed) (*orgSecretParameters, bool) {
+			cr, ok := mg.(*v1alpha1.OrganizationDependabotSecret)
+			if !ok {
+				return nil, false
+			}
+			return (*orgSecretParameters)(&cr.Spec.ForProvider), true
+		},
+		observe: func(mg resource.Managed, s *github.Secret, repos []*github.Repository) {
+			mg.(*v1alpha1.OrganizationDependabotSecret).Status.AtProvider = v1alpha1.OrganizationDependabotSecretObservation(secretstores.GenerateOrgObservation(s, repos))
+		},
+	}
+
+	codespacesSecretKind = orgSecretKind{
+		store:     secretstores.StoreCodespaces,
+		kind:      v1alpha1.OrganizationCodespacesSecretKind,
+		groupKind: v1alpha1.OrganizationCodespacesSecretGroupKind,
+		gvk:       v1alpha1.OrganizationCodespacesSecretGroupVersionKind,
+		object:    func() resource.Managed { return &v1alpha1.OrganizationCodespacesSecret{} },
+		parameters: func(mg resource.Managed) (*orgSecretParameters, bool) {
+			cr, ok := mg.(*v1alpha1.OrganizationCodespacesSecret)
+			if !ok {
+				return nil, false
+			}
+			return (*orgSecretParameters)(&cr.Spec.ForProvider), true
+		},
+		observe: func(mg resource.Managed, s *github.Secret, repos []*github.Repository) {
+			mg.(*v1alpha1.OrganizationCodespacesSecret).Status.AtProvider = v1alpha1.OrganizationCodespacesSecretObservation(secretstores.GenerateOrgObservation(s, repos))
+		},
+	}
+)
+
+// SetupOrganizationActionsSecret adds a controller that reconciles
+// OrganizationActionsSecrets.
+func SetupOrganizationActionsSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	return setupOrgSecret(mgr, l, rl, actionsSecretKind)
+}
+
+// SetupOrganizationDependabotSecret adds a controller that reconciles
+// OrganizationDependabotSecrets.
+func SetupOrganizationDependabotSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	return setupOrgSecret(mgr, l, rl, dependabotSecretKind)
+}
+
+// SetupOrganizationCodespacesSecret adds a controller that reconciles
+// OrganizationCodespacesSecrets.
+func SetupOrganizationCodespacesSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	return setupOrgSecret(mgr, l, rl, codespacesSecretKind)
+}
+
+func setupOrgSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, k orgSecretKind) error {
+	name := managed.ControllerName(k.groupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(k.object()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(k.gvk),
+			managed.WithExternalConnecter(&orgSecretConnector{kind: k, client: mgr.GetClient(), newClientFn: secretstores.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type orgSecretConnector struct {
+	kind        orgSecretKind
+	client      client.Client
+	newClientFn func(cfg ghclient.Config, store string) (*secretstores.Service, error)
+}
+
+func (c *orgSecretConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := c.kind.parameters(mg); !ok {
+		return nil, errors.Errorf(errUnexpectedSecret, c.kind.kind)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg, c.kind.store)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &orgSecretExternal{c.kind, *gh, c.client}, nil
+}
+
+type orgSecretExternal struct {
+	kind   orgSecretKind
+	gh     secretstores.Service
+	client client.Client
+}
+
+func (e *orgSecretExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	s, _, err := e.gh.GetOrgSecret(ctx, p.Organization, p.Name)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetSecret, e.kind.kind)
+	}
+
+	var repos []*github.Repository
+	if s.Visibility == v1alpha1.VisibilitySelected {
+		repos, err = e.gh.ListSelectedReposForOrgSecret(ctx, p.Organization, p.Name)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrapf(err, errGetSelectedRepositories, e.kind.kind)
+		}
+	}
+
+	v, err := ghclient.GetSecretValue(ctx, e.client, p.ValueSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetSecretValue, e.kind.kind)
+	}
+	key, _, err := e.gh.GetOrgPublicKey(ctx, p.Organization)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetPublicKey, e.kind.kind)
+	}
+
+	e.kind.observe(mgd, s, repos)
+	mgd.SetConditions(xpv1.Available())
+
+	// GitHub never returns the value of a secret. It is sent again if it
+	// changed, or if it was encrypted with a public key of the store that
+	// was rotated since.
+	return managed.ExternalObservation{
+		ResourceUpToDate: secretstores.IsOrgSecretUpToDate(v1alpha1.OrganizationActionsSecretParameters(*p), s, repos) &&
+			ghclient.IsEncryptedSecretUpToDate(mgd, v.Version, key.GetKeyID()),
+		ResourceExists: true,
+	}, nil
+}
+
+func (e *orgSecretExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	if err := e.put(ctx, mgd, p, errCreateSecret); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	mgd.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *orgSecretExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	return managed.ExternalUpdate{}, e.put(ctx, mgd, p, errUpdateSecret)
+}
+
+func (e *orgSecretExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	_, err := e.gh.DeleteOrgSecret(ctx, p.Organization, p.Name)
+	return errors.Wrapf(resource.Ignore(ghclient.IsNotFound, err), errDeleteSecret, e.kind.kind)
+}
+
+// put encrypts the value of the secret with the current public key of the
+// store of the organization and sends it to GitHub, together with its
+// visibility and the selected repositories. The version of the value and
+// the ID of the key are recorded in the annotations of the managed resource.
+// An error sending the secret is wrapped with errPut.
+func (e *orgSecretExternal) put(ctx context.Context, mgd resource.Managed, p *orgSecretParameters, errPut string) error {
+	v, err := ghclient.GetSecretValue(ctx, e.client, p.ValueSecretRef)
+	if err != nil {
+		return errors.Wrapf(err, errGetSecretValue, e.kind.kind)
+	}
+	key, _, err := e.gh.GetOrgPublicKey(ctx, p.Organization)
+	if err != nil {
+		return errors.Wrapf(err, errGetPublicKey, e.kind.kind)
+	}
+	s, err := ghclient.EncryptSecret(p.Name, key, v.Value)
+	if err != nil {
+		return errors.Wrapf(err, errEncryptSecret, e.kind.kind)
+	}
+	secretstores.GenerateOrgSecret(v1alpha1.OrganizationActionsSecretParameters(*p), s)
+	if _, err := e.gh.CreateOrUpdateOrgSecret(ctx, p.Organization, s); err != nil {
+		return errors.Wrapf(err, errPut, e.kind.kind)
+	}
+
+	ghclient.SetEncryptedSecret(mgd, v.Version, key.GetKeyID())
+	return errors.Wrapf(e.client.Update(ctx, mgd), errKubeUpdateSecret, e.kind.kind)
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/box"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+	repofake "github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeSecretName  = "DEPLOY_TOKEN"
+	fakeSecretValue = "t0k3n"
+	fakePublicKeyID = "568250167242549743"
+
+	fakeBoxPublicKey, fakeBoxPrivateKey, _ = box.GenerateKey(rand.Reader)
+
+	orgSecretKinds = []orgSecretKind{actionsSecretKind, dependabotSecretKind, codespacesSecretKind}
+)
+
+type orgSecretModifier func(mg resource.Managed, p *orgSecretParameters)
+
+func withSelectedRepositoryIDs(ids ...int64) orgSecretModifier {
+	return func(_ resource.Managed, p *orgSecretParameters) {
+		p.Visibility = v1alpha1.VisibilitySelected
+		p.SelectedRepositoryIDs = ids
+	}
+}
+
+func withEncryptedSecret(v, keyID string) orgSecretModifier {
+	return func(mg resource.Managed, _ *orgSecretParameters) {
+		ghclient.SetEncryptedSecret(mg, secretVersion(v), keyID)
+	}
+}
+
+func newOrgSecret(k orgSecretKind, m ...orgSecretModifier) resource.Managed {
+	r := k.object()
+	p, _ := k.parameters(r)
+	*p = orgSecretParameters{
+		Organization: fakeOrg,
+		Name:         fakeSecretName,
+		ValueSecretRef: xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "deploy-token", Namespace: "crossplane-system"},
+			Key:             "secret",
+		},
+		Visibility: v1alpha1.VisibilityPrivate,
+	}
+	for _, f := range m {
+		f(r, p)
+	}
+	return r
+}
+
+func orgPublicKey(keyID string) func(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+	return func(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+		return &github.PublicKey{
+			KeyID: github.String(keyID),
+			Key:   github.String(base64.StdEncoding.EncodeToString(fakeBoxPublicKey[:])),
+		}, nil, nil
+	}
+}
+
+func observedOrgSecret(visibility string) func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+	return func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+		return &github.Secret{Name: name, Visibility: visibility}, nil, nil
+	}
+}
+
+func selectedRepositories(ids ...int64) func(ctx context.Context, org, name string) ([]*github.Repository, error) {
+	return func(ctx context.Context, org, name string) ([]*github.Repository, error) {
+		var repos []*github.Repository
+		for _, id := range ids {
+			repos = append(repos, &github.Repository{ID: github.Int64(id)})
+		}
+		return repos, nil
+	}
+}
+
+type orgSecretArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github secretstores.Service
+}
+
+func TestOrgSecretObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	for _, k := range orgSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   orgSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: orgSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"CannotGetSecret": {
+				reason: "Must return an error if GET secret fails and the error is not 404",
+				args: orgSecretArgs{
+					mg: newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return nil, nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errGetSecret, k.kind),
+				},
+			},
+			"NotFound": {
+				reason: "Must not return an error if the secret does not exist",
+				args: orgSecretArgs{
+					mg: newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return nil, nil, errNotFound
+						},
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{},
+				},
+			},
+			"CannotGetSelectedRepositories": {
+				reason: "Must return an error if the selected repositories cannot be listed",
+				args: orgSecretArgs{
+					mg: newOrgSecret(k, withSelectedRepositoryIDs(1)),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret: observedOrgSecret(v1alpha1.VisibilitySelected),
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string) ([]*github.Repository, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errGetSelectedRepositories, k.kind),
+				},
+			},
+			"UpToDate": {
+				reason: "Must return ResourceUpToDate as true if neither the value, the public key nor the visibility changed",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k, withEncryptedSecret(fakeSecretValue, fakePublicKeyID)),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret:    observedOrgSecret(v1alpha1.VisibilityPrivate),
+						MockGetOrgPublicKey: orgPublicKey(fakePublicKeyID),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+					},
+				},
+			},
+			"SelectedRepositoriesUpToDate": {
+				reason: "Must return ResourceUpToDate as true if all pages of selected repositories match",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k, withSelectedRepositoryIDs(2, 1), withEncryptedSecret(fakeSecretValue, fakePublicKeyID)),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret:                  observedOrgSecret(v1alpha1.VisibilitySelected),
+						MockListSelectedReposForOrgSecret: selectedRepositories(1, 2),
+						MockGetOrgPublicKey:               orgPublicKey(fakePublicKeyID),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+					},
+				},
+			},
+			"RepositorySelected": {
+				reason: "Must return ResourceUpToDate as false if a repository was selected since",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k, withSelectedRepositoryIDs(1, 2, 3), withEncryptedSecret(fakeSecretValue, fakePublicKeyID)),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret:                  observedOrgSecret(v1alpha1.VisibilitySelected),
+						MockListSelectedReposForOrgSecret: selectedRepositories(1, 2),
+						MockGetOrgPublicKey:               orgPublicKey(fakePublicKeyID),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: false,
+					},
+				},
+			},
+			"VisibilityChanged": {
+				reason: "Must return ResourceUpToDate as false if the visibility of the secret changed",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k, withEncryptedSecret(fakeSecretValue, fakePublicKeyID)),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret:    observedOrgSecret(v1alpha1.VisibilityAll),
+						MockGetOrgPublicKey: orgPublicKey(fakePublicKeyID),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: false,
+					},
+				},
+			},
+			"PublicKeyRotated": {
+				reason: "Must return ResourceUpToDate as false if the public key of the organization was rotated",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k, withEncryptedSecret(fakeSecretValue, fakePublicKeyID)),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgSecret:    observedOrgSecret(v1alpha1.VisibilityPrivate),
+						MockGetOrgPublicKey: orgPublicKey("1234"),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: false,
+					},
+				},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := orgSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					got, err := e.Observe(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+						t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+					}
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestOrgSecretCreate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalCreation
+		err error
+	}
+
+	for _, k := range orgSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   orgSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: orgSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"CreationFailed": {
+				reason: "Must return an error if the secret cannot be created",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgPublicKey: orgPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateOrgSecret: func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errCreateSecret, k.kind),
+				},
+			},
+			"Success": {
+				reason: "Must send the encrypted value together with the selected repositories",
+				args: orgSecretArgs{
+					kube: &test.MockClient{
+						MockGet:    webhookSecret(fakeSecretValue),
+						MockUpdate: test.NewMockUpdateFn(nil),
+					},
+					mg: newOrgSecret(k, withSelectedRepositoryIDs(1, 2)),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgPublicKey: orgPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateOrgSecret: func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							sealed, err := base64.StdEncoding.DecodeString(eSecret.EncryptedValue)
+							if err != nil {
+								return nil, err
+							}
+							v, ok := box.OpenAnonymous(nil, sealed, fakeBoxPublicKey, fakeBoxPrivateKey)
+							if !ok || string(v) != fakeSecretValue {
+								return nil, errBoom
+							}
+							if eSecret.Visibility != v1alpha1.VisibilitySelected ||
+								!cmp.Equal(eSecret.SelectedRepositoryIDs, github.SelectedRepoIDs{1, 2}) {
+								return nil, errBoom
+							}
+							return nil, nil
+						},
+					},
+				},
+				want: want{
+					eo: managed.ExternalCreation{},
+				},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := orgSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					got, err := e.Create(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+						t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+					}
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestOrgSecretUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	for _, k := range orgSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   orgSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: orgSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"CannotGetPublicKey": {
+				reason: "Must return an error if the public key of the organization cannot be read",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgPublicKey: func(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+							return nil, nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errGetPublicKey, k.kind),
+				},
+			},
+			"UpdateFailed": {
+				reason: "Must return an error if the secret cannot be sent",
+				args: orgSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgPublicKey: orgPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateOrgSecret: func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errUpdateSecret, k.kind),
+				},
+			},
+			"KubeUpdateFailed": {
+				reason: "Must return an error if the version of the value cannot be recorded",
+				args: orgSecretArgs{
+					kube: &test.MockClient{
+						MockGet:    webhookSecret(fakeSecretValue),
+						MockUpdate: test.NewMockUpdateFn(errBoom),
+					},
+					mg: newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgPublicKey: orgPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateOrgSecret: func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							return nil, nil
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errKubeUpdateSecret, k.kind),
+				},
+			},
+			"Success": {
+				reason: "Must not send selected repositories if the visibility is not selected",
+				args: orgSecretArgs{
+					kube: &test.MockClient{
+						MockGet:    webhookSecret(fakeSecretValue),
+						MockUpdate: test.NewMockUpdateFn(nil),
+					},
+					mg: newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockGetOrgPublicKey: orgPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateOrgSecret: func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							if eSecret.Visibility != v1alpha1.VisibilityPrivate || eSecret.SelectedRepositoryIDs != nil {
+								return nil, errBoom
+							}
+							return nil, nil
+						},
+					},
+				},
+				want: want{},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := orgSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					_, err := e.Update(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestOrgSecretDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	for _, k := range orgSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   orgSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: orgSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"DeleteFailed": {
+				reason: "Must return an error if DELETE secret fails and the error is not 404",
+				args: orgSecretArgs{
+					mg: newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockDeleteOrgSecret: func(ctx context.Context, org, name string) (*github.Response, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errDeleteSecret, k.kind),
+				},
+			},
+			"NotFound": {
+				reason: "Must not return an error if the secret was already deleted",
+				args: orgSecretArgs{
+					mg: newOrgSecret(k),
+					github: &repofake.MockSecretStoreService{
+						MockDeleteOrgSecret: func(ctx context.Context, org, name string) (*github.Response, error) {
+							return nil, errNotFound
+						},
+					},
+				},
+				want: want{
+					err: nil,
+				},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := orgSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					err := e.Delete(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teams"
+)
+
+const (
+	errUnexpectedTeam = "The managed resource is not a Team resource"
+	errGetTeam        = "cannot get Team"
+	errCreateTeam     = "cannot create Team"
+	errUpdateTeam     = "cannot update Team"
+	errDeleteTeam     = "cannot delete Team"
+	errKubeUpdateTeam = "cannot update Team custom resource"
+)
+
+// SetupTeam adds a controller that reconciles Teams.
+func SetupTeam(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TeamGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Team{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TeamGroupVersionKind),
+			managed.WithExternalConnecter(&teamConnector{client: mgr.GetClient(), newClientFn: teams.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(
+				managed.NewDefaultProviderConfig(mgr.GetClient()),
+				managed.NewNameAsExternalName(mgr.GetClient()),
+			),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type teamConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*teams.Service, error)
+}
+
+func (c *teamConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return nil, errors.New(errUnexpectedTeam)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &teamExternal{*gh, c.client}, nil
+}
+
+type teamExternal struct {
+	gh     teams.Service
+	client client.Client
+}
+
+func (e *teamExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedTeam)
+	}
+
+	t, _, err := e.gh.Get(ctx, cr.Spec.ForProvider.Organization, meta.GetExternalName(cr))
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTeam)
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	teams.LateInitialize(&cr.Spec.ForProvider, t)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateTeam)
+		}
+		lateInit = true
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = teams.GenerateObservation(*t)
+
+	return managed.ExternalObservation{
+		ResourceUpToDate:        teams.IsUpToDate(cr.Spec.ForProvider, t),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *teamExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedTeam)
+	}
+
+	t, _, err := e.gh.Create(ctx, cr.Spec.ForProvider.Organization, teams.GenerateTeamRequest(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTeam)
+	}
+
+	// GitHub derives the slug that identifies the Team from its name.
+	meta.SetExternalName(cr, t.GetSlug())
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *teamExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedTeam)
+	}
+
+	slug := meta.GetExternalName(cr)
+	t, _, err := e.gh.Edit(ctx, cr.Spec.ForProvider.Organization, slug, teams.GenerateTeamRequest(cr.Spec.ForProvider, slug))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTeam)
+	}
+
+	// Renaming a Team changes its slug. The new slug must be stored right
+	// away, the Team can no longer be found with the old one.
+	if t.GetSlug() != slug {
+		meta.SetExternalName(cr, t.GetSlug())
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateTeam)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *teamExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Team)
+	if !ok {
+		return errors.New(errUnexpectedTeam)
+	}
+
+	_, err := e.gh.Delete(ctx, cr.Spec.ForProvider.Organization, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteTeam)
+}
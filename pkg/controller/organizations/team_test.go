@@ -0,0 +1,432 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teams"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/organizations/fake"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+	errNotFound      = &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	fakeOrg  = "crossplane"
+	fakeSlug = "platform"
+	fakeName = "Platform"
+)
+
+type teamOption func(*v1alpha1.Team)
+
+func newTeam(opts ...teamOption) *v1alpha1.Team {
+	t := &v1alpha1.Team{}
+	t.Spec.ForProvider.Organization = fakeOrg
+	meta.SetExternalName(t, fakeSlug)
+
+	for _, f := range opts {
+		f(t)
+	}
+	return t
+}
+
+func withTeamName(name string) teamOption {
+	return func(t *v1alpha1.Team) { t.Spec.ForProvider.Name = &name }
+}
+
+func withTeamExternalName(name string) teamOption {
+	return func(t *v1alpha1.Team) { meta.SetExternalName(t, name) }
+}
+
+func ghTeam(name, slug string) *teams.Team {
+	return &teams.Team{Team: github.Team{Name: &name, Slug: &slug}}
+}
+
+type teamArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github teams.Service
+}
+
+func TestTeamObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   teamArgs
+		want   want
+	}{
+		"ResourceIsNotTeam": {
+			reason: "Must return an error if the resource is not a Team",
+			args: teamArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedTeam),
+			},
+		},
+		"CannotGetTeam": {
+			reason: "Must return an error if GET team fails and the error is not 404",
+			args: teamArgs{
+				mg: newTeam(),
+				github: &fake.MockTeamService{
+					MockGet: func(ctx context.Context, org, slug string) (*teams.Team, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetTeam),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if GET team returns 404",
+			args: teamArgs{
+				mg: newTeam(),
+				github: &fake.MockTeamService{
+					MockGet: func(ctx context.Context, org, slug string) (*teams.Team, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"LateInitFailed": {
+			reason: "Must return an error if the late initialized Team cannot be updated",
+			args: teamArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newTeam(),
+				github: &fake.MockTeamService{
+					MockGet: func(ctx context.Context, org, slug string) (*teams.Team, *github.Response, error) {
+						return ghTeam(fakeName, slug), nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateTeam),
+			},
+		},
+		"LateInitialized": {
+			reason: "Must late initialize the name of the Team",
+			args: teamArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newTeam(),
+				github: &fake.MockTeamService{
+					MockGet: func(ctx context.Context, org, slug string) (*teams.Team, *github.Response, error) {
+						return ghTeam(fakeName, slug), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			reason: "Must return ResourceUpToDate as false if the Team is outdated",
+			args: teamArgs{
+				mg: newTeam(withTeamName("Platform Team")),
+				github: &fake.MockTeamService{
+					MockGet: func(ctx context.Context, org, slug string) (*teams.Team, *github.Response, error) {
+						return ghTeam(fakeName, slug), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamExternal{
+				client: tc.args.kube,
+				gh:     tc.args.github,
+			}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamCreate(t *testing.T) {
+	type want struct {
+		ec           managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   teamArgs
+		want   want
+	}{
+		"ResourceIsNotTeam": {
+			reason: "Must return an error if the resource is not a Team",
+			args: teamArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedTeam),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the team creation fails",
+			args: teamArgs{
+				mg: newTeam(),
+				github: &fake.MockTeamService{
+					MockCreate: func(ctx context.Context, org string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				externalName: fakeSlug,
+				err:          errors.Wrap(errBoom, errCreateTeam),
+			},
+		},
+		"Success": {
+			reason: "Must use the slug of the created Team as its external name",
+			args: teamArgs{
+				mg: newTeam(withTeamName("Platform Team")),
+				github: &fake.MockTeamService{
+					MockCreate: func(ctx context.Context, org string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+						return ghTeam(team.Name, "platform-team"), nil, nil
+					},
+				},
+			},
+			want: want{
+				ec:           managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "platform-team",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamExternal{
+				gh: tc.args.github,
+			}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.ec, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.args.mg == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamUpdate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   teamArgs
+		want   want
+	}{
+		"ResourceIsNotTeam": {
+			reason: "Must return an error if the resource is not a Team",
+			args: teamArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedTeam),
+			},
+		},
+		"EditFailed": {
+			reason: "Must return an error if the team update fails",
+			args: teamArgs{
+				mg: newTeam(),
+				github: &fake.MockTeamService{
+					MockEdit: func(ctx context.Context, org, slug string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				externalName: fakeSlug,
+				err:          errors.Wrap(errBoom, errUpdateTeam),
+			},
+		},
+		"Success": {
+			reason: "Must not update the custom resource if the slug did not change",
+			args: teamArgs{
+				mg: newTeam(withTeamName(fakeName)),
+				github: &fake.MockTeamService{
+					MockEdit: func(ctx context.Context, org, slug string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+						return ghTeam(team.Name, slug), nil, nil
+					},
+				},
+			},
+			want: want{
+				externalName: fakeSlug,
+			},
+		},
+		"RenameFailed": {
+			reason: "Must return an error if the new slug cannot be stored",
+			args: teamArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newTeam(withTeamName("Platform Team")),
+				github: &fake.MockTeamService{
+					MockEdit: func(ctx context.Context, org, slug string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+						return ghTeam(team.Name, "platform-team"), nil, nil
+					},
+				},
+			},
+			want: want{
+				externalName: "platform-team",
+				err:          errors.Wrap(errBoom, errKubeUpdateTeam),
+			},
+		},
+		"Renamed": {
+			reason: "Must store the new slug of a renamed Team as its external name",
+			args: teamArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newTeam(withTeamName("Platform Team")),
+				github: &fake.MockTeamService{
+					MockEdit: func(ctx context.Context, org, slug string, team *teams.TeamRequest) (*teams.Team, *github.Response, error) {
+						return ghTeam(team.Name, "platform-team"), nil, nil
+					},
+				},
+			},
+			want: want{
+				externalName: "platform-team",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamExternal{
+				client: tc.args.kube,
+				gh:     tc.args.github,
+			}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.args.mg == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   teamArgs
+		want   error
+	}{
+		"ResourceIsNotTeam": {
+			reason: "Must return an error if the resource is not a Team",
+			args: teamArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedTeam),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the team deletion fails",
+			args: teamArgs{
+				mg: newTeam(withTeamExternalName(fakeSlug)),
+				github: &fake.MockTeamService{
+					MockDelete: func(ctx context.Context, org, slug string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteTeam),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the team is already gone",
+			args: teamArgs{
+				mg: newTeam(),
+				github: &fake.MockTeamService{
+					MockDelete: func(ctx context.Context, org, slug string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamExternal{
+				gh: tc.args.github,
+			}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
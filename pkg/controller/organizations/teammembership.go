@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teammemberships"
+)
+
+const (
+	errUnexpectedTeamMembership = "The managed resource is not a TeamMembership resource"
+	errGetTeamMembership        = "cannot get TeamMembership"
+	errCreateTeamMembership     = "cannot create TeamMembership"
+	errUpdateTeamMembership     = "cannot update TeamMembership"
+	errDeleteTeamMembership     = "cannot delete TeamMembership"
+	errKubeUpdateTeamMembership = "cannot update TeamMembership custom resource"
+)
+
+// SetupTeamMembership adds a controller that reconciles TeamMemberships.
+func SetupTeamMembership(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TeamMembershipGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.TeamMembership{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TeamMembershipGroupVersionKind),
+			managed.WithExternalConnecter(&teamMembershipConnector{client: mgr.GetClient(), newClientFn: teammemberships.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type teamMembershipConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*teammemberships.Service, error)
+}
+
+func (c *teamMembershipConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TeamMembership)
+	if !ok {
+		return nil, errors.New(errUnexpectedTeamMembership)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &teamMembershipExternal{*gh, c.client}, nil
+}
+
+type teamMembershipExternal struct {
+	gh     teammemberships.Service
+	client client.Client
+}
+
+func (e *teamMembershipExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.TeamMembership)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedTeamMembership)
+	}
+
+	p := cr.Spec.ForProvider
+	m, _, err := e.gh.GetTeamMembershipBySlug(ctx, p.Organization, p.Team, p.User)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTeamMembership)
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	teammemberships.LateInitialize(&cr.Spec.ForProvider, m)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateTeamMembership)
+		}
+		lateInit = true
+	}
+
+	cr.Status.AtProvider = teammemberships.GenerateObservation(*m)
+
+	// Users that are not members of the organization yet are invited to
+	// the Team. The membership is pending until they accept.
+	if m.GetState() == "active" {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceUpToDate:        teammemberships.IsUpToDate(cr.Spec.ForProvider, m),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *teamMembershipExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TeamMembership)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedTeamMembership)
+	}
+
+	p := cr.Spec.ForProvider
+	_, _, err := e.gh.AddTeamMembershipBySlug(ctx, p.Organization, p.Team, p.User, teammemberships.GenerateAddOptions(p))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTeamMembership)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *teamMembershipExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.TeamMembership)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedTeamMembership)
+	}
+
+	// Adding a user that is already a member of the Team changes their role.
+	p := cr.Spec.ForProvider
+	_, _, err := e.gh.AddTeamMembershipBySlug(ctx, p.Organization, p.Team, p.User, teammemberships.GenerateAddOptions(p))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTeamMembership)
+}
+
+func (e *teamMembershipExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TeamMembership)
+	if !ok {
+		return errors.New(errUnexpectedTeamMembership)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.RemoveTeamMembershipBySlug(ctx, p.Organization, p.Team, p.User)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteTeamMembership)
+}
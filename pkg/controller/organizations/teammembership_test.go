@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teammemberships"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/organizations/fake"
+)
+
+var (
+	fakeUser       = "octocat"
+	fakeMember     = "member"
+	fakeMaintainer = "maintainer"
+	fakeActive     = "active"
+	fakePending    = "pending"
+)
+
+type teamMembershipOption func(*v1alpha1.TeamMembership)
+
+func newTeamMembership(opts ...teamMembershipOption) *v1alpha1.TeamMembership {
+	m := &v1alpha1.TeamMembership{}
+	m.Spec.ForProvider.Organization = fakeOrg
+	m.Spec.ForProvider.Team = fakeSlug
+	m.Spec.ForProvider.User = fakeUser
+
+	for _, f := range opts {
+		f(m)
+	}
+	return m
+}
+
+func withTeamMembershipRole(role string) teamMembershipOption {
+	return func(m *v1alpha1.TeamMembership) { m.Spec.ForProvider.Role = &role }
+}
+
+type teamMembershipArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github teammemberships.Service
+}
+
+func TestTeamMembershipObserve(t *testing.T) {
+	type want struct {
+		eo    managed.ExternalObservation
+		ready xpv1.Condition
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   teamMembershipArgs
+		want   want
+	}{
+		"ResourceIsNotTeamMembership": {
+			reason: "Must return an error if the resource is not a TeamMembership",
+			args: teamMembershipArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedTeamMembership),
+			},
+		},
+		"CannotGetTeamMembership": {
+			reason: "Must return an error if GET team membership fails and the error is not 404",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(),
+				github: &fake.MockTeamMembershipService{
+					MockGetTeamMembershipBySlug: func(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetTeamMembership),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if GET team membership returns 404",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(),
+				github: &fake.MockTeamMembershipService{
+					MockGetTeamMembershipBySlug: func(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"LateInitialized": {
+			reason: "Must late initialize the role of the TeamMembership",
+			args: teamMembershipArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newTeamMembership(),
+				github: &fake.MockTeamMembershipService{
+					MockGetTeamMembershipBySlug: func(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error) {
+						return &github.Membership{State: &fakeActive, Role: &fakeMember}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				ready: xpv1.Available(),
+			},
+		},
+		"Pending": {
+			reason: "Must not be available while the invitation to the Team is pending",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(withTeamMembershipRole(fakeMember)),
+				github: &fake.MockTeamMembershipService{
+					MockGetTeamMembershipBySlug: func(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error) {
+						return &github.Membership{State: &fakePending, Role: &fakeMember}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				ready: xpv1.Creating(),
+			},
+		},
+		"NotUpToDate": {
+			reason: "Must return ResourceUpToDate as false if the role changed",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(withTeamMembershipRole(fakeMaintainer)),
+				github: &fake.MockTeamMembershipService{
+					MockGetTeamMembershipBySlug: func(ctx context.Context, org, slug, user string) (*github.Membership, *github.Response, error) {
+						return &github.Membership{State: &fakeActive, Role: &fakeMember}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				ready: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamMembershipExternal{
+				client: tc.args.kube,
+				gh:     tc.args.github,
+			}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.ready.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.ready, tc.args.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want ready, +got ready:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamMembershipCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   teamMembershipArgs
+		want   error
+	}{
+		"ResourceIsNotTeamMembership": {
+			reason: "Must return an error if the resource is not a TeamMembership",
+			args: teamMembershipArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedTeamMembership),
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the user cannot be added to the Team",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(),
+				github: &fake.MockTeamMembershipService{
+					MockAddTeamMembershipBySlug: func(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errCreateTeamMembership),
+		},
+		"Success": {
+			reason: "Must add the user to the Team with the desired role",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(withTeamMembershipRole(fakeMaintainer)),
+				github: &fake.MockTeamMembershipService{
+					MockAddTeamMembershipBySlug: func(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+						if opts.Role != fakeMaintainer {
+							return nil, nil, errBoom
+						}
+						return &github.Membership{}, nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamMembershipExternal{
+				gh: tc.args.github,
+			}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamMembershipUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   teamMembershipArgs
+		want   error
+	}{
+		"ResourceIsNotTeamMembership": {
+			reason: "Must return an error if the resource is not a TeamMembership",
+			args: teamMembershipArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedTeamMembership),
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the role cannot be changed",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(withTeamMembershipRole(fakeMaintainer)),
+				github: &fake.MockTeamMembershipService{
+					MockAddTeamMembershipBySlug: func(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errUpdateTeamMembership),
+		},
+		"Success": {
+			reason: "Must not return an error if the role was changed",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(withTeamMembershipRole(fakeMaintainer)),
+				github: &fake.MockTeamMembershipService{
+					MockAddTeamMembershipBySlug: func(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+						return &github.Membership{}, nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamMembershipExternal{
+				gh: tc.args.github,
+			}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamMembershipDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   teamMembershipArgs
+		want   error
+	}{
+		"ResourceIsNotTeamMembership": {
+			reason: "Must return an error if the resource is not a TeamMembership",
+			args: teamMembershipArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedTeamMembership),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the user cannot be removed from the Team",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(),
+				github: &fake.MockTeamMembershipService{
+					MockRemoveTeamMembershipBySlug: func(ctx context.Context, org, slug, user string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteTeamMembership),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the user is no longer a member of the Team",
+			args: teamMembershipArgs{
+				mg: newTeamMembership(),
+				github: &fake.MockTeamMembershipService{
+					MockRemoveTeamMembershipBySlug: func(ctx context.Context, org, slug, user string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamMembershipExternal{
+				gh: tc.args.github,
+			}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
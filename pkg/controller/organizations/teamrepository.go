@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teamrepositories"
+)
+
+const (
+	errUnexpectedTeamRepository = "The managed resource is not a TeamRepository resource"
+	errGetTeamRepository        = "cannot get TeamRepository"
+	errCreateTeamRepository     = "cannot create TeamRepository"
+	errUpdateTeamRepository     = "cannot update TeamRepository"
+	errDeleteTeamRepository     = "cannot delete TeamRepository"
+)
+
+// SetupTeamRepository adds a controller that reconciles TeamRepositories.
+func SetupTeamRepository(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TeamRepositoryGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.TeamRepository{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TeamRepositoryGroupVersionKind),
+			managed.WithExternalConnecter(&teamRepositoryConnector{client: mgr.GetClient(), newClientFn: teamrepositories.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type teamRepositoryConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*teamrepositories.Service, error)
+}
+
+func (c *teamRepositoryConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TeamRepository)
+	if !ok {
+		return nil, errors.New(errUnexpectedTeamRepository)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &teamRepositoryExternal{*gh}, nil
+}
+
+type teamRepositoryExternal struct {
+	gh teamrepositories.Service
+}
+
+func (e *teamRepositoryExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.TeamRepository)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedTeamRepository)
+	}
+
+	p := cr.Spec.ForProvider
+	r, _, err := e.gh.Get(ctx, p.Organization, p.Team, p.Organization, p.Repository)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTeamRepository)
+	}
+
+	cr.Status.AtProvider = teamrepositories.GenerateObservation(r)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate: teamrepositories.IsUpToDate(p, r),
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *teamRepositoryExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TeamRepository)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedTeamRepository)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.Add(ctx, p.Organization, p.Team, p.Organization, p.Repository, p.Permission)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTeamRepository)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *teamRepositoryExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.TeamRepository)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedTeamRepository)
+	}
+
+	// Adding a repository the Team already has access to changes the
+	// permission of the Team.
+	p := cr.Spec.ForProvider
+	_, err := e.gh.Add(ctx, p.Organization, p.Team, p.Organization, p.Repository, p.Permission)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTeamRepository)
+}
+
+func (e *teamRepositoryExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TeamRepository)
+	if !ok {
+		return errors.New(errUnexpectedTeamRepository)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.Remove(ctx, p.Organization, p.Team, p.Organization, p.Repository)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteTeamRepository)
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/teamrepositories"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/organizations/fake"
+)
+
+var fakeRepository = "sample"
+
+func newTeamRepository(permission string) *v1alpha1.TeamRepository {
+	r := &v1alpha1.TeamRepository{}
+	r.Spec.ForProvider = v1alpha1.TeamRepositoryParameters{
+		Organization: fakeOrg,
+		Team:         fakeSlug,
+		Repository:   fakeRepository,
+		Permission:   permission,
+	}
+	return r
+}
+
+type teamRepositoryArgs struct {
+	mg     resource.Managed
+	github teamrepositories.Service
+}
+
+func TestTeamRepositoryObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   teamRepositoryArgs
+		want   want
+	}{
+		"ResourceIsNotTeamRepository": {
+			reason: "Must return an error if the resource is not a TeamRepository",
+			args: teamRepositoryArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedTeamRepository),
+			},
+		},
+		"CannotGetTeamRepository": {
+			reason: "Must return an error if GET team repository fails and the error is not 404",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("push"),
+				github: &fake.MockTeamRepositoryService{
+					MockGet: func(ctx context.Context, org, slug, owner, repo string) (*teamrepositories.Repository, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetTeamRepository),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the Team has no access to the repository",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("push"),
+				github: &fake.MockTeamRepositoryService{
+					MockGet: func(ctx context.Context, org, slug, owner, repo string) (*teamrepositories.Repository, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the permission did not change",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("push"),
+				github: &fake.MockTeamRepositoryService{
+					MockGet: func(ctx context.Context, org, slug, owner, repo string) (*teamrepositories.Repository, *github.Response, error) {
+						return &teamrepositories.Repository{RoleName: github.String("write")}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			reason: "Must return ResourceUpToDate as false if the permission changed",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("admin"),
+				github: &fake.MockTeamRepositoryService{
+					MockGet: func(ctx context.Context, org, slug, owner, repo string) (*teamrepositories.Repository, *github.Response, error) {
+						return &teamrepositories.Repository{RoleName: github.String("write")}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamRepositoryExternal{gh: tc.args.github}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamRepositoryCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   teamRepositoryArgs
+		want   error
+	}{
+		"ResourceIsNotTeamRepository": {
+			reason: "Must return an error if the resource is not a TeamRepository",
+			args: teamRepositoryArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedTeamRepository),
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the repository cannot be added to the Team",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("push"),
+				github: &fake.MockTeamRepositoryService{
+					MockAdd: func(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errCreateTeamRepository),
+		},
+		"Success": {
+			reason: "Must grant the Team the desired permission",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("security-engineer"),
+				github: &fake.MockTeamRepositoryService{
+					MockAdd: func(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error) {
+						if permission != "security-engineer" {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamRepositoryExternal{gh: tc.args.github}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamRepositoryUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   teamRepositoryArgs
+		want   error
+	}{
+		"ResourceIsNotTeamRepository": {
+			reason: "Must return an error if the resource is not a TeamRepository",
+			args: teamRepositoryArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedTeamRepository),
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the permission cannot be changed",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("admin"),
+				github: &fake.MockTeamRepositoryService{
+					MockAdd: func(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errUpdateTeamRepository),
+		},
+		"Success": {
+			reason: "Must not return an error if the permission was changed",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("admin"),
+				github: &fake.MockTeamRepositoryService{
+					MockAdd: func(ctx context.Context, org, slug, owner, repo, permission string) (*github.Response, error) {
+						return nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamRepositoryExternal{gh: tc.args.github}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTeamRepositoryDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   teamRepositoryArgs
+		want   error
+	}{
+		"ResourceIsNotTeamRepository": {
+			reason: "Must return an error if the resource is not a TeamRepository",
+			args: teamRepositoryArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedTeamRepository),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the repository cannot be removed from the Team",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("push"),
+				github: &fake.MockTeamRepositoryService{
+					MockRemove: func(ctx context.Context, org, slug, owner, repo string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteTeamRepository),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the Team no longer has access to the repository",
+			args: teamRepositoryArgs{
+				mg: newTeamRepository("push"),
+				github: &fake.MockTeamRepositoryService{
+					MockRemove: func(ctx context.Context, org, slug, owner, repo string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := teamRepositoryExternal{gh: tc.args.github}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
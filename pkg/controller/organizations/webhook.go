@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/webhooks"
+)
+
+const (
+	errUnexpectedWebhook = "The managed resource is not an OrganizationWebhook resource"
+	errWebhookID         = "cannot parse the ID of OrganizationWebhook from its external name"
+	errGetWebhookSecret  = "cannot get secret of OrganizationWebhook"
+	errGetWebhook        = "cannot get OrganizationWebhook"
+	errGetDeliveries     = "cannot get deliveries of OrganizationWebhook"
+	errCreateWebhook     = "cannot create OrganizationWebhook"
+	errUpdateWebhook     = "cannot update OrganizationWebhook"
+	errDeleteWebhook     = "cannot delete OrganizationWebhook"
+	errKubeUpdateWebhook = "cannot update OrganizationWebhook custom resource"
+)
+
+// SetupOrganizationWebhook adds a controller that reconciles
+// OrganizationWebhooks.
+func SetupOrganizationWebhook(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.OrganizationWebhookGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.OrganizationWebhook{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OrganizationWebhookGroupVersionKind),
+			managed.WithExternalConnecter(&webhookConnector{client: mgr.GetClient(), newClientFn: webhooks.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type webhookConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*webhooks.Service, error)
+}
+
+func (c *webhookConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationWebhook)
+	if !ok {
+		return nil, errors.New(errUnexpectedWebhook)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &webhookExternal{*gh, c.client}, nil
+}
+
+type webhookExternal struct {
+	gh     webhooks.Service
+	client client.Client
+}
+
+func (e *webhookExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationWebhook)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedWebhook)
+	}
+
+	// The external name is the ID GitHub assigns to the webhook when it is
+	// created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errWebhookID)
+	}
+
+	p := cr.Spec.ForProvider
+	h, _, err := e.gh.GetOrganizationHook(ctx, p.Organization, id)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetWebhook)
+	}
+
+	// GitHub Enterprise Server versions that do not record deliveries do
+	// not have the endpoint.
+	d, err := webhooks.LastOrganizationHookDelivery(ctx, e.gh, p.Organization, id)
+	if resource.Ignore(ghclient.IsNotFound, err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDeliveries)
+	}
+
+	_, version, err := webhooks.GetSecret(ctx, e.client, p.SecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetWebhookSecret)
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	webhooks.LateInitialize(&cr.Spec.ForProvider.WebhookParameters, h)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateWebhook)
+		}
+		lateInit = true
+	}
+
+	cr.Status.AtProvider = webhooks.GenerateOrganizationHookObservation(h, d)
+	cr.SetConditions(xpv1.Available())
+
+	// GitHub never returns the secret, so a change of its value is detected
+	// by the version of the Secret it was last read from.
+	return managed.ExternalObservation{
+		ResourceUpToDate: webhooks.IsUpToDate(cr.Spec.ForProvider.WebhookParameters, h) &&
+			ghclient.IsSecretVersionUpToDate(cr, version),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *webhookExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationWebhook)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedWebhook)
+	}
+
+	p := cr.Spec.ForProvider
+	secret, version, err := webhooks.GetSecret(ctx, e.client, p.SecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetWebhookSecret)
+	}
+
+	h, _, err := e.gh.CreateOrganizationHook(ctx, p.Organization, webhooks.GenerateHook(p.WebhookParameters, secret))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateWebhook)
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(h.GetID(), 10))
+	ghclient.SetSecretVersion(cr, version)
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, errors.Wrap(e.client.Update(ctx, cr), errKubeUpdateWebhook)
+}
+
+func (e *webhookExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OrganizationWebhook)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedWebhook)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errWebhookID)
+	}
+
+	p := cr.Spec.ForProvider
+	secret, version, err := webhooks.GetSecret(ctx, e.client, p.SecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetWebhookSecret)
+	}
+
+	if _, _, err := e.gh.EditOrganizationHook(ctx, p.Organization, id, webhooks.GenerateHook(p.WebhookParameters, secret)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWebhook)
+	}
+
+	ghclient.SetSecretVersion(cr, version)
+	return managed.ExternalUpdate{}, errors.Wrap(e.client.Update(ctx, cr), errKubeUpdateWebhook)
+}
+
+func (e *webhookExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OrganizationWebhook)
+	if !ok {
+		return errors.New(errUnexpectedWebhook)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, errWebhookID)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err = e.gh.DeleteOrganizationHook(ctx, p.Organization, id)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteWebhook)
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/variables"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeVariableName  = "REGISTRY"
+	fakeVariableValue = "registry.example.org"
+)
+
+type actionsVariableModifier func(*v1alpha1.ActionsVariable)
+
+func withVariableConfigMapRef() actionsVariableModifier {
+	return func(r *v1alpha1.ActionsVariable) {
+		r.Spec.ForProvider.Value = nil
+		r.Spec.ForProvider.ValueConfigMapRef = &v1alpha1.ConfigMapKeySelector{
+			Name:      "registry",
+			Namespace: "crossplane-system",
+			Key:       "host",
+		}
+	}
+}
+
+func newActionsVariable(m ...actionsVariableModifier) *v1alpha1.ActionsVariable {
+	r := &v1alpha1.ActionsVariable{}
+	r.Spec.ForProvider = v1alpha1.ActionsVariableParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		Name:       fakeVariableName,
+		Value:      &fakeVariableValue,
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func variableConfigMap(value string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.ConfigMap).Data = map[string]string{"host": value}
+		return nil
+	}
+}
+
+func observedVariable(value string) *variables.Variable {
+	return &variables.Variable{Name: fakeVariableName, Value: value}
+}
+
+type actionsVariableArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github variables.Service
+}
+
+func TestActionsVariableObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotActionsVariable": {
+			reason: "Must return an error if the resource is not an ActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"CannotGetActionsVariable": {
+			reason: "Must return an error if GET variable fails and the error is not 404",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockGetRepoVariable: func(ctx context.Context, owner, repo, name string) (*variables.Variable, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetActionsVariable),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the variable does not exist",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockGetRepoVariable: func(ctx context.Context, owner, repo, name string) (*variables.Variable, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"CannotGetConfigMap": {
+			reason: "Must return an error if the referenced ConfigMap cannot be read",
+			args: actionsVariableArgs{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg:   newActionsVariable(withVariableConfigMapRef()),
+				github: &fake.MockVariableService{
+					MockGetRepoVariable: func(ctx context.Context, owner, repo, name string) (*variables.Variable, *github.Response, error) {
+						return observedVariable(fakeVariableValue), nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get ConfigMap"), errGetActionsVariableValue),
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the value did not change",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockGetRepoVariable: func(ctx context.Context, owner, repo, name string) (*variables.Variable, *github.Response, error) {
+						return observedVariable(fakeVariableValue), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ConfigMapValueChanged": {
+			reason: "Must return ResourceUpToDate as false if the value in the ConfigMap changed",
+			args: actionsVariableArgs{
+				kube: &test.MockClient{MockGet: variableConfigMap("mirror.example.org")},
+				mg:   newActionsVariable(withVariableConfigMapRef()),
+				github: &fake.MockVariableService{
+					MockGetRepoVariable: func(ctx context.Context, owner, repo, name string) (*variables.Variable, *github.Response, error) {
+						return observedVariable(fakeVariableValue), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestActionsVariableCreate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotActionsVariable": {
+			reason: "Must return an error if the resource is not an ActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"NoValue": {
+			reason: "Must return an error if neither a value nor a ConfigMap is given",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(func(r *v1alpha1.ActionsVariable) { r.Spec.ForProvider.Value = nil }),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("neither a value nor a ConfigMap holding it is given"), errGetActionsVariableValue),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the variable cannot be created",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockCreateRepoVariable: func(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateActionsVariable),
+			},
+		},
+		"Success": {
+			reason: "Must create the variable with the value of the ConfigMap",
+			args: actionsVariableArgs{
+				kube: &test.MockClient{MockGet: variableConfigMap(fakeVariableValue)},
+				mg:   newActionsVariable(withVariableConfigMapRef()),
+				github: &fake.MockVariableService{
+					MockCreateRepoVariable: func(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error) {
+						if v.Name != fakeVariableName || v.Value != fakeVariableValue {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalCreation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestActionsVariableUpdate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotActionsVariable": {
+			reason: "Must return an error if the resource is not an ActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the variable cannot be updated",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockUpdateRepoVariable: func(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateActionsVariable),
+			},
+		},
+		"Success": {
+			reason: "Must update the variable with the inline value",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockUpdateRepoVariable: func(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error) {
+						if v.Value != fakeVariableValue {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalUpdate{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestActionsVariableDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotActionsVariable": {
+			reason: "Must return an error if the resource is not an ActionsVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedActionsVariable),
+			},
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if DELETE variable fails and the error is not 404",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockDeleteRepoVariable: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteActionsVariable),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the variable was already deleted",
+			args: actionsVariableArgs{
+				mg: newActionsVariable(),
+				github: &fake.MockVariableService{
+					MockDeleteRepoVariable: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := actionsVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/branches"
+)
+
+const (
+	errUnexpectedBranch = "The managed resource is not a Branch resource"
+	errGetBranch        = "cannot get Branch"
+	errGetBranchSource  = "cannot get the commit to create Branch from"
+	errCreateBranch     = "cannot create Branch"
+	errDeleteBranch     = "cannot delete Branch"
+)
+
+// SetupBranch adds a controller that reconciles Branches.
+func SetupBranch(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.BranchGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Branch{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BranchGroupVersionKind),
+			managed.WithExternalConnecter(&branchConnector{client: mgr.GetClient(), newClientFn: branches.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type branchConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*branches.Service, error)
+}
+
+func (c *branchConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return nil, errors.New(errUnexpectedBranch)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &branchExternal{*gh}, nil
+}
+
+type branchExternal struct {
+	gh branches.Service
+}
+
+func (e *branchExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedBranch)
+	}
+
+	p := cr.Spec.ForProvider
+	r, _, err := e.gh.GetRef(ctx, p.Owner, p.Repository, branches.Ref(p.Name))
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetBranch)
+	}
+
+	cr.Status.AtProvider = branches.GenerateObservation(r)
+	cr.SetConditions(xpv1.Available())
+
+	// The source of a branch only matters when it is created, the commits
+	// pushed to it afterwards are no drift.
+	return managed.ExternalObservation{
+		ResourceUpToDate: true,
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *branchExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedBranch)
+	}
+
+	p := cr.Spec.ForProvider
+	sha, err := branches.SourceSHA(ctx, e.gh, p)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetBranchSource)
+	}
+
+	if _, _, err := e.gh.CreateRef(ctx, p.Owner, p.Repository, branches.GenerateReference(p, sha)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBranch)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *branchExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	// A Branch is always up to date, there is nothing to update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *branchExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Branch)
+	if !ok {
+		return errors.New(errUnexpectedBranch)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.DeleteRef(ctx, p.Owner, p.Repository, branches.Ref(p.Name))
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteBranch)
+}
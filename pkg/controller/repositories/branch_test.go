@@ -0,0 +1,326 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/branches"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeBranchName = "develop"
+	fakeMainSHA    = "7638417db6d59f3c431d3e1f261cc637155684cd"
+	fakeReleaseSHA = "aa218f56b14c9653891f9e74264a383fa43fefbd"
+)
+
+type branchModifier func(*v1alpha1.Branch)
+
+func withSourceBranch(branch string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.SourceBranch = &branch }
+}
+
+func withSourceSHA(sha string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.SourceSHA = &sha }
+}
+
+func newBranch(m ...branchModifier) *v1alpha1.Branch {
+	r := &v1alpha1.Branch{}
+	r.Spec.ForProvider = v1alpha1.BranchParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		Name:       fakeBranchName,
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+// branchHeads returns a GetRef fake that finds the branches main and release
+// of the Repository.
+func branchHeads(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error) {
+	heads := map[string]string{"heads/main": fakeMainSHA, "heads/release": fakeReleaseSHA}
+	sha, ok := heads[ref]
+	if !ok {
+		return nil, nil, errNotFound
+	}
+	return &github.Reference{Ref: github.String("refs/" + ref), Object: &github.GitObject{SHA: &sha}}, nil, nil
+}
+
+func defaultBranch(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	return &github.Repository{DefaultBranch: github.String("main")}, nil, nil
+}
+
+type branchArgs struct {
+	mg     resource.Managed
+	github branches.Service
+}
+
+func TestBranchObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   branchArgs
+		want   want
+	}{
+		"ResourceIsNotBranch": {
+			reason: "Must return an error if the resource is not a Branch",
+			args: branchArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedBranch),
+			},
+		},
+		"CannotGetBranch": {
+			reason: "Must return an error if GET ref fails and the error is not 404",
+			args: branchArgs{
+				mg: newBranch(),
+				github: &fake.MockBranchService{
+					MockGetRef: func(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetBranch),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the branch does not exist",
+			args: branchArgs{
+				mg: newBranch(),
+				github: &fake.MockBranchService{
+					MockGetRef: branchHeads,
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"Exists": {
+			reason: "Must be up to date wherever the branch points to",
+			args: branchArgs{
+				mg: newBranch(withSourceSHA(fakeReleaseSHA)),
+				github: &fake.MockBranchService{
+					MockGetRef: func(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error) {
+						return branchHeads(ctx, owner, repo, "heads/main")
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := branchExternal{gh: tc.args.github}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBranchCreate(t *testing.T) {
+	type want struct {
+		sha string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   branchArgs
+		want   want
+	}{
+		"ResourceIsNotBranch": {
+			reason: "Must return an error if the resource is not a Branch",
+			args: branchArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedBranch),
+			},
+		},
+		"CannotGetSourceBranch": {
+			reason: "Must return an error if the head of the source branch cannot be read",
+			args: branchArgs{
+				mg: newBranch(withSourceBranch("feature")),
+				github: &fake.MockBranchService{
+					MockGetRef: func(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetBranchSource),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the branch cannot be created",
+			args: branchArgs{
+				mg: newBranch(withSourceSHA(fakeReleaseSHA)),
+				github: &fake.MockBranchService{
+					MockCreateRef: func(ctx context.Context, owner, repo string, ref *github.Reference) (*github.Reference, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateBranch),
+			},
+		},
+		"SourceSHA": {
+			reason: "Must create the branch at the given commit",
+			args: branchArgs{
+				mg: newBranch(withSourceSHA(fakeReleaseSHA), withSourceBranch("main")),
+			},
+			want: want{
+				sha: fakeReleaseSHA,
+			},
+		},
+		"SourceBranch": {
+			reason: "Must create the branch at the head of the source branch",
+			args: branchArgs{
+				mg: newBranch(withSourceBranch("release")),
+				github: &fake.MockBranchService{
+					MockGetRef: branchHeads,
+				},
+			},
+			want: want{
+				sha: fakeReleaseSHA,
+			},
+		},
+		"DefaultBranch": {
+			reason: "Must create the branch at the head of the default branch if no source is given",
+			args: branchArgs{
+				mg: newBranch(),
+				github: &fake.MockBranchService{
+					MockGetRepository: defaultBranch,
+					MockGetRef:        branchHeads,
+				},
+			},
+			want: want{
+				sha: fakeMainSHA,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created *github.Reference
+			gh, ok := tc.args.github.(*fake.MockBranchService)
+			if !ok {
+				gh = &fake.MockBranchService{}
+			}
+			if gh.MockCreateRef == nil {
+				gh.MockCreateRef = func(ctx context.Context, owner, repo string, ref *github.Reference) (*github.Reference, *github.Response, error) {
+					created = ref
+					return ref, nil, nil
+				}
+			}
+			e := branchExternal{gh: gh}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.sha != "" {
+				want := &github.Reference{
+					Ref:    github.String("refs/heads/" + fakeBranchName),
+					Object: &github.GitObject{SHA: &tc.want.sha},
+				}
+				if diff := cmp.Diff(want, created); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want ref, +got ref:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBranchDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   branchArgs
+		want   error
+	}{
+		"ResourceIsNotBranch": {
+			reason: "Must return an error if the resource is not a Branch",
+			args: branchArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedBranch),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the branch cannot be deleted",
+			args: branchArgs{
+				mg: newBranch(),
+				github: &fake.MockBranchService{
+					MockDeleteRef: func(ctx context.Context, owner, repo, ref string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteBranch),
+		},
+		"NotFound": {
+			reason: "Must not return an error if the branch is already gone",
+			args: branchArgs{
+				mg: newBranch(),
+				github: &fake.MockBranchService{
+					MockDeleteRef: func(ctx context.Context, owner, repo, ref string) (*github.Response, error) {
+						if ref != "heads/"+fakeBranchName {
+							return nil, errBoom
+						}
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := branchExternal{gh: tc.args.github}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/branchprotections"
+)
+
+const (
+	errUnexpectedBranchProtection = "The managed resource is not a BranchProtection resource"
+	errGetBranchProtection        = "cannot get BranchProtection"
+	errCreateBranchProtection     = "cannot create BranchProtection"
+	errUpdateBranchProtection     = "cannot update BranchProtection"
+	errDeleteBranchProtection     = "cannot delete BranchProtection"
+	errKubeUpdateBranchProtection = "cannot update BranchProtection custom resource"
+)
+
+// SetupBranchProtection adds a controller that reconciles BranchProtections.
+func SetupBranchProtection(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.BranchProtectionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.BranchProtection{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BranchProtectionGroupVersionKind),
+			managed.WithExternalConnecter(&branchProtectionConnector{client: mgr.GetClient(), newClientFn: branchprotections.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type branchProtectionConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*branchprotections.Service, error)
+}
+
+func (c *branchProtectionConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BranchProtection)
+	if !ok {
+		return nil, errors.New(errUnexpectedBranchProtection)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &branchProtectionExternal{*gh, c.client}, nil
+}
+
+type branchProtectionExternal struct {
+	gh     branchprotections.Service
+	client client.Client
+}
+
+func (e *branchProtectionExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.BranchProtection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedBranchProtection)
+	}
+
+	p := cr.Spec.ForProvider
+	pr, _, err := e.gh.GetBranchProtection(ctx, p.Owner, p.Repository, p.Branch)
+	if err != nil {
+		// GitHub responds with 404 if the branch is not protected.
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetBranchProtection)
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	branchprotections.LateInitialize(&cr.Spec.ForProvider, pr)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateBranchProtection)
+		}
+		lateInit = true
+	}
+
+	cr.Status.AtProvider = branchprotections.GenerateObservation(pr)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate:        branchprotections.IsUpToDate(cr.Spec.ForProvider, pr),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *branchProtectionExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.BranchProtection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedBranchProtection)
+	}
+
+	p := cr.Spec.ForProvider
+	_, _, err := e.gh.UpdateBranchProtection(ctx, p.Owner, p.Repository, p.Branch, branchprotections.GenerateProtectionRequest(p))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBranchProtection)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *branchProtectionExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.BranchProtection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedBranchProtection)
+	}
+
+	p := cr.Spec.ForProvider
+	_, _, err := e.gh.UpdateBranchProtection(ctx, p.Owner, p.Repository, p.Branch, branchprotections.GenerateProtectionRequest(p))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBranchProtection)
+}
+
+func (e *branchProtectionExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.BranchProtection)
+	if !ok {
+		return errors.New(errUnexpectedBranchProtection)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.RemoveBranchProtection(ctx, p.Owner, p.Repository, p.Branch)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteBranchProtection)
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/branchprotections"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var fakeBranch = "main"
+
+func newBranchProtection(enforceAdmins bool) *v1alpha1.BranchProtection {
+	b := &v1alpha1.BranchProtection{}
+	b.Spec.ForProvider = v1alpha1.BranchProtectionParameters{
+		Owner:                fakeOwner,
+		Repository:           fakeRepository,
+		Branch:               fakeBranch,
+		RequiredStatusChecks: &v1alpha1.RequiredStatusChecks{Strict: true},
+		EnforceAdmins:        &enforceAdmins,
+	}
+	return b
+}
+
+func observedProtection() *github.Protection {
+	return &github.Protection{
+		RequiredStatusChecks: &github.RequiredStatusChecks{Strict: true, Contexts: []string{}},
+		EnforceAdmins:        &github.AdminEnforcement{Enabled: true},
+	}
+}
+
+type branchProtectionArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github branchprotections.Service
+}
+
+func TestBranchProtectionObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   branchProtectionArgs
+		want   want
+	}{
+		"ResourceIsNotBranchProtection": {
+			reason: "Must return an error if the resource is not a BranchProtection",
+			args: branchProtectionArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedBranchProtection),
+			},
+		},
+		"CannotGetBranchProtection": {
+			reason: "Must return an error if GET branch protection fails and the error is not 404",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetBranchProtection),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the branch is not protected",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the protection did not change",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+						return observedProtection(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			reason: "Must return ResourceUpToDate as false if the protection changed",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(false),
+				github: &fake.MockBranchProtectionService{
+					MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+						return observedProtection(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitFailed": {
+			reason: "Must return an error if the late initialized spec cannot be saved",
+			args: branchProtectionArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+						p := observedProtection()
+						p.RequireLinearHistory = &github.RequireLinearHistory{Enabled: true}
+						return p, nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateBranchProtection),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := branchProtectionExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBranchProtectionCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   branchProtectionArgs
+		want   error
+	}{
+		"ResourceIsNotBranchProtection": {
+			reason: "Must return an error if the resource is not a BranchProtection",
+			args: branchProtectionArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedBranchProtection),
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the branch cannot be protected",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockUpdateBranchProtection: func(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errCreateBranchProtection),
+		},
+		"Success": {
+			reason: "Must protect the branch with the desired settings",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockUpdateBranchProtection: func(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error) {
+						if branch != fakeBranch || !preq.EnforceAdmins {
+							return nil, nil, errBoom
+						}
+						return observedProtection(), nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := branchProtectionExternal{gh: tc.args.github}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBranchProtectionUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   branchProtectionArgs
+		want   error
+	}{
+		"ResourceIsNotBranchProtection": {
+			reason: "Must return an error if the resource is not a BranchProtection",
+			args: branchProtectionArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedBranchProtection),
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the protection cannot be updated",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(false),
+				github: &fake.MockBranchProtectionService{
+					MockUpdateBranchProtection: func(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errUpdateBranchProtection),
+		},
+		"Success": {
+			reason: "Must not return an error if the protection was updated",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(false),
+				github: &fake.MockBranchProtectionService{
+					MockUpdateBranchProtection: func(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error) {
+						return observedProtection(), nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := branchProtectionExternal{gh: tc.args.github}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBranchProtectionDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   branchProtectionArgs
+		want   error
+	}{
+		"ResourceIsNotBranchProtection": {
+			reason: "Must return an error if the resource is not a BranchProtection",
+			args: branchProtectionArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedBranchProtection),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the protection cannot be removed",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockRemoveBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteBranchProtection),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the branch is no longer protected",
+			args: branchProtectionArgs{
+				mg: newBranchProtection(true),
+				github: &fake.MockBranchProtectionService{
+					MockRemoveBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := branchProtectionExternal{gh: tc.args.github}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
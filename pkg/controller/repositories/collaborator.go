@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/collaborators"
+)
+
+const (
+	errUnexpectedCollaborator = "The managed resource is not a RepositoryCollaborator resource"
+	errGetCollaborator        = "cannot get RepositoryCollaborator"
+	errGetInvitation          = "cannot get invitation of RepositoryCollaborator"
+	errCreateCollaborator     = "cannot create RepositoryCollaborator"
+	errDeleteInvitation       = "cannot delete expired invitation of RepositoryCollaborator"
+	errUpdateCollaborator     = "cannot update RepositoryCollaborator"
+	errDeleteCollaborator     = "cannot delete RepositoryCollaborator"
+	errKubeUpdateCollaborator = "cannot update RepositoryCollaborator custom resource"
+)
+
+// SetupRepositoryCollaborator adds a controller that reconciles
+// RepositoryCollaborators.
+func SetupRepositoryCollaborator(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.RepositoryCollaboratorGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.RepositoryCollaborator{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryCollaboratorGroupVersionKind),
+			managed.WithExternalConnecter(&collaboratorConnector{client: mgr.GetClient(), newClientFn: collaborators.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type collaboratorConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*collaborators.Service, error)
+}
+
+func (c *collaboratorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryCollaborator)
+	if !ok {
+		return nil, errors.New(errUnexpectedCollaborator)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &collaboratorExternal{*gh, c.client}, nil
+}
+
+type collaboratorExternal struct {
+	gh     collaborators.Service
+	client client.Client
+}
+
+func (e *collaboratorExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.RepositoryCollaborator)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedCollaborator)
+	}
+
+	p := cr.Spec.ForProvider
+	isCollaborator, _, err := e.gh.IsCollaborator(ctx, p.Owner, p.Repository, p.User)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCollaborator)
+	}
+
+	if isCollaborator {
+		pl, _, err := e.gh.GetPermissionLevel(ctx, p.Owner, p.Repository, p.User)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetCollaborator)
+		}
+		cr.Status.AtProvider = collaborators.GenerateCollaboratorObservation(pl)
+		cr.SetConditions(xpv1.Available())
+	} else {
+		i, err := collaborators.FindInvitation(ctx, e.gh, p.Owner, p.Repository, p.User)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetInvitation)
+		}
+		if i == nil {
+			cr.Status.AtProvider = v1alpha1.RepositoryCollaboratorObservation{}
+			return managed.ExternalObservation{}, nil
+		}
+		cr.Status.AtProvider = collaborators.GenerateInvitationObservation(i)
+
+		// An expired invitation can no longer be accepted. It is replaced
+		// by a new one.
+		if cr.Status.AtProvider.State == v1alpha1.CollaboratorStateExpired {
+			return managed.ExternalObservation{}, nil
+		}
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	collaborators.LateInitialize(&cr.Spec.ForProvider, cr.Status.AtProvider.Permission)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateCollaborator)
+		}
+		lateInit = true
+	}
+
+	return managed.ExternalObservation{
+		ResourceUpToDate:        collaborators.IsUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider.Permission),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *collaboratorExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.RepositoryCollaborator)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedCollaborator)
+	}
+
+	p := cr.Spec.ForProvider
+	if cr.Status.AtProvider.State == v1alpha1.CollaboratorStateExpired {
+		_, err := e.gh.DeleteInvitation(ctx, p.Owner, p.Repository, cr.Status.AtProvider.InvitationID)
+		if err := resource.Ignore(ghclient.IsNotFound, err); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errDeleteInvitation)
+		}
+	}
+
+	_, _, err := e.gh.AddCollaborator(ctx, p.Owner, p.Repository, p.User, &github.RepositoryAddCollaboratorOptions{
+		Permission: ghclient.StringValue(p.Permission),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCollaborator)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *collaboratorExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.RepositoryCollaborator)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedCollaborator)
+	}
+
+	p := cr.Spec.ForProvider
+	var err error
+	if cr.Status.AtProvider.State == v1alpha1.CollaboratorStatePending {
+		_, _, err = e.gh.UpdateInvitation(ctx, p.Owner, p.Repository, cr.Status.AtProvider.InvitationID,
+			ghclient.RepositoryRoleName(ghclient.StringValue(p.Permission)))
+	} else {
+		// Adding an existing collaborator changes their permission.
+		_, _, err = e.gh.AddCollaborator(ctx, p.Owner, p.Repository, p.User, &github.RepositoryAddCollaboratorOptions{
+			Permission: ghclient.StringValue(p.Permission),
+		})
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCollaborator)
+}
+
+func (e *collaboratorExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.RepositoryCollaborator)
+	if !ok {
+		return errors.New(errUnexpectedCollaborator)
+	}
+
+	p := cr.Spec.ForProvider
+	var err error
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.CollaboratorStatePending, v1alpha1.CollaboratorStateExpired:
+		_, err = e.gh.DeleteInvitation(ctx, p.Owner, p.Repository, cr.Status.AtProvider.InvitationID)
+	default:
+		_, err = e.gh.RemoveCollaborator(ctx, p.Owner, p.Repository, p.User)
+	}
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteCollaborator)
+}
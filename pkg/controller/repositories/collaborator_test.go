@@ -0,0 +1,457 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/collaborators"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	errNotFound      = &github.ErrorResponse{Response: &http.Response{StatusCode: notFound}}
+	fakeRepository   = "sample"
+	fakeCollaborator = "octocat"
+	fakeInvitationID = int64(42)
+)
+
+type collaboratorOption func(*v1alpha1.RepositoryCollaborator)
+
+func newCollaborator(opts ...collaboratorOption) *v1alpha1.RepositoryCollaborator {
+	c := &v1alpha1.RepositoryCollaborator{}
+	c.Spec.ForProvider = v1alpha1.RepositoryCollaboratorParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		User:       fakeCollaborator,
+	}
+	for _, f := range opts {
+		f(c)
+	}
+	return c
+}
+
+func withPermission(permission string) collaboratorOption {
+	return func(c *v1alpha1.RepositoryCollaborator) { c.Spec.ForProvider.Permission = &permission }
+}
+
+func withCollaboratorState(state string) collaboratorOption {
+	return func(c *v1alpha1.RepositoryCollaborator) {
+		c.Status.AtProvider.State = state
+		c.Status.AtProvider.InvitationID = fakeInvitationID
+	}
+}
+
+type collaboratorArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github collaborators.Service
+}
+
+func TestCollaboratorObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	isCollaborator := func(is bool) func(ctx context.Context, owner, repo, user string) (bool, *github.Response, error) {
+		return func(ctx context.Context, owner, repo, user string) (bool, *github.Response, error) {
+			return is, nil, nil
+		}
+	}
+	invitations := func(i ...*collaborators.Invitation) func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*collaborators.Invitation, *github.Response, error) {
+		return func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*collaborators.Invitation, *github.Response, error) {
+			return i, &github.Response{}, nil
+		}
+	}
+	invitation := func(permissions string, expired bool) *collaborators.Invitation {
+		return &collaborators.Invitation{
+			RepositoryInvitation: github.RepositoryInvitation{
+				ID:          github.Int64(fakeInvitationID),
+				Invitee:     &github.User{Login: github.String(fakeCollaborator)},
+				Permissions: github.String(permissions),
+			},
+			Expired: &expired,
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   collaboratorArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryCollaborator": {
+			reason: "Must return an error if the resource is not a RepositoryCollaborator",
+			args: collaboratorArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedCollaborator),
+			},
+		},
+		"CannotGetCollaborator": {
+			reason: "Must return an error if checking the collaborator fails",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push")),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator: func(ctx context.Context, owner, repo, user string) (bool, *github.Response, error) {
+						return false, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetCollaborator),
+			},
+		},
+		"CannotGetInvitation": {
+			reason: "Must return an error if listing the invitations fails",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push")),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator: isCollaborator(false),
+					MockListInvitations: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*collaborators.Invitation, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetInvitation),
+			},
+		},
+		"NotFound": {
+			reason: "Must return ResourceExists as false if the user is neither a collaborator nor invited",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push")),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator:  isCollaborator(false),
+					MockListInvitations: invitations(),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"InvitationExpired": {
+			reason: "Must return ResourceExists as false if the invitation expired",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push")),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator:  isCollaborator(false),
+					MockListInvitations: invitations(invitation("write", true)),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"InvitationPending": {
+			reason: "Must return ResourceUpToDate as true if the user is invited with the desired permission",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push")),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator:  isCollaborator(false),
+					MockListInvitations: invitations(invitation("write", false)),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			reason: "Must return ResourceUpToDate as false if the permission changed",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("admin")),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator: isCollaborator(true),
+					MockGetPermissionLevel: func(ctx context.Context, owner, repo, user string) (*collaborators.PermissionLevel, *github.Response, error) {
+						return &collaborators.PermissionLevel{RoleName: github.String("maintain")}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			reason: "Must late initialize the permission of the collaborator",
+			args: collaboratorArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newCollaborator(),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator: isCollaborator(true),
+					MockGetPermissionLevel: func(ctx context.Context, owner, repo, user string) (*collaborators.PermissionLevel, *github.Response, error) {
+						return &collaborators.PermissionLevel{RoleName: github.String("write")}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"LateInitFailed": {
+			reason: "Must return an error if the late initialized spec cannot be saved",
+			args: collaboratorArgs{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newCollaborator(),
+				github: &fake.MockCollaboratorService{
+					MockIsCollaborator: isCollaborator(true),
+					MockGetPermissionLevel: func(ctx context.Context, owner, repo, user string) (*collaborators.PermissionLevel, *github.Response, error) {
+						return &collaborators.PermissionLevel{RoleName: github.String("write")}, nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateCollaborator),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := collaboratorExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCollaboratorCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   collaboratorArgs
+		want   error
+	}{
+		"ResourceIsNotRepositoryCollaborator": {
+			reason: "Must return an error if the resource is not a RepositoryCollaborator",
+			args: collaboratorArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedCollaborator),
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the collaborator cannot be added",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push")),
+				github: &fake.MockCollaboratorService{
+					MockAddCollaborator: func(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errCreateCollaborator),
+		},
+		"DeleteExpiredInvitationFailed": {
+			reason: "Must return an error if the expired invitation cannot be deleted",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push"), withCollaboratorState(v1alpha1.CollaboratorStateExpired)),
+				github: &fake.MockCollaboratorService{
+					MockDeleteInvitation: func(ctx context.Context, owner, repo string, invitationID int64) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteInvitation),
+		},
+		"ResendExpiredInvitation": {
+			reason: "Must replace an expired invitation with a new one",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push"), withCollaboratorState(v1alpha1.CollaboratorStateExpired)),
+				github: &fake.MockCollaboratorService{
+					MockDeleteInvitation: func(ctx context.Context, owner, repo string, invitationID int64) (*github.Response, error) {
+						if invitationID != fakeInvitationID {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+					MockAddCollaborator: func(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+						return &github.CollaboratorInvitation{}, nil, nil
+					},
+				},
+			},
+		},
+		"Success": {
+			reason: "Must invite the user with the desired permission",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("maintain")),
+				github: &fake.MockCollaboratorService{
+					MockAddCollaborator: func(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+						if opts.Permission != "maintain" {
+							return nil, nil, errBoom
+						}
+						return &github.CollaboratorInvitation{}, nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := collaboratorExternal{gh: tc.args.github}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCollaboratorUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   collaboratorArgs
+		want   error
+	}{
+		"ResourceIsNotRepositoryCollaborator": {
+			reason: "Must return an error if the resource is not a RepositoryCollaborator",
+			args: collaboratorArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedCollaborator),
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the permission of the collaborator cannot be changed",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("admin"), withCollaboratorState(v1alpha1.CollaboratorStateActive)),
+				github: &fake.MockCollaboratorService{
+					MockAddCollaborator: func(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errUpdateCollaborator),
+		},
+		"UpdateInvitation": {
+			reason: "Must change the permission of a pending invitation",
+			args: collaboratorArgs{
+				mg: newCollaborator(withPermission("push"), withCollaboratorState(v1alpha1.CollaboratorStatePending)),
+				github: &fake.MockCollaboratorService{
+					MockUpdateInvitation: func(ctx context.Context, owner, repo string, invitationID int64, permissions string) (*github.RepositoryInvitation, *github.Response, error) {
+						if invitationID != fakeInvitationID || permissions != "write" {
+							return nil, nil, errBoom
+						}
+						return &github.RepositoryInvitation{}, nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := collaboratorExternal{gh: tc.args.github}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCollaboratorDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   collaboratorArgs
+		want   error
+	}{
+		"ResourceIsNotRepositoryCollaborator": {
+			reason: "Must return an error if the resource is not a RepositoryCollaborator",
+			args: collaboratorArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedCollaborator),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the collaborator cannot be removed",
+			args: collaboratorArgs{
+				mg: newCollaborator(withCollaboratorState(v1alpha1.CollaboratorStateActive)),
+				github: &fake.MockCollaboratorService{
+					MockRemoveCollaborator: func(ctx context.Context, owner, repo, user string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteCollaborator),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the user is no longer a collaborator",
+			args: collaboratorArgs{
+				mg: newCollaborator(withCollaboratorState(v1alpha1.CollaboratorStateActive)),
+				github: &fake.MockCollaboratorService{
+					MockRemoveCollaborator: func(ctx context.Context, owner, repo, user string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+		"DeleteInvitation": {
+			reason: "Must withdraw a pending invitation",
+			args: collaboratorArgs{
+				mg: newCollaborator(withCollaboratorState(v1alpha1.CollaboratorStatePending)),
+				github: &fake.MockCollaboratorService{
+					MockDeleteInvitation: func(ctx context.Context, owner, repo string, invitationID int64) (*github.Response, error) {
+						if invitationID != fakeInvitationID {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := collaboratorExternal{gh: tc.args.github}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/deploykeys"
+)
+
+const (
+	errUnexpectedDeployKey = "The managed resource is not a DeployKey resource"
+	errDeployKeyID         = "cannot parse the ID of DeployKey from its external name"
+	errGenerateKeyPair     = "cannot generate key pair of DeployKey"
+	errGetDeployKey        = "cannot get DeployKey"
+	errNoConnectionSecret  = "cannot generate key pair of DeployKey without a connection Secret to write its private key to"
+	errGetConnectionSecret = "cannot get connection Secret of DeployKey"
+	errCreateDeployKey     = "cannot create DeployKey"
+	errDeleteDeployKey     = "cannot delete DeployKey"
+	errKubeUpdateDeployKey = "cannot update DeployKey custom resource"
+)
+
+// Keys of the connection details of a DeployKey.
+const (
+	connectionKeyPublicKey  = "publicKey"
+	connectionKeyPrivateKey = "privateKey"
+)
+
+// SetupDeployKey adds a controller that reconciles DeployKeys.
+func SetupDeployKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.DeployKeyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.DeployKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DeployKeyGroupVersionKind),
+			managed.WithExternalConnecter(&deployKeyConnector{client: mgr.GetClient(), reader: mgr.GetAPIReader(), newClientFn: deploykeys.NewService}),
+			managed.WithConnectionPublishers(managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type deployKeyConnector struct {
+	client      client.Client
+	reader      client.Reader
+	newClientFn func(ghclient.Config) (*deploykeys.Service, error)
+}
+
+func (c *deployKeyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DeployKey)
+	if !ok {
+		return nil, errors.New(errUnexpectedDeployKey)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &deployKeyExternal{*gh, c.client, c.reader, rand.Reader}, nil
+}
+
+type deployKeyExternal struct {
+	gh     deploykeys.Service
+	client client.Client
+	// reader reads the connection Secret bypassing the cache of client, which
+	// may not hold it yet right after it is published.
+	reader client.Reader
+	rand   io.Reader
+}
+
+func (e *deployKeyExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DeployKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedDeployKey)
+	}
+
+	// The external name is the ID GitHub assigns to the deploy key when it
+	// is added.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDeployKeyID)
+	}
+
+	p := cr.Spec.ForProvider
+	k, _, err := e.gh.GetKey(ctx, p.Owner, p.Repository, id)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDeployKey)
+	}
+
+	// The private key of a generated key pair only exists in the connection
+	// Secret. If it is lost, the deploy key is outdated and Update rotates it.
+	upToDate := true
+	if p.Key == nil {
+		published, err := e.isPrivateKeyPublished(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetConnectionSecret)
+		}
+		upToDate = published
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	deploykeys.LateInitialize(&cr.Spec.ForProvider, k)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateDeployKey)
+		}
+		lateInit = true
+	}
+
+	cr.Status.AtProvider = deploykeys.GenerateObservation(k)
+	cr.SetConditions(xpv1.Available())
+
+	// Deploy keys cannot be changed once they are added.
+	return managed.ExternalObservation{
+		ResourceUpToDate:        upToDate,
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+		ConnectionDetails: managed.ConnectionDetails{
+			connectionKeyPublicKey: []byte(k.GetKey()),
+		},
+	}, nil
+}
+
+func (e *deployKeyExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.DeployKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedDeployKey)
+	}
+
+	k, conn, err := e.add(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, strconv.FormatInt(k.GetID(), 10))
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: conn}, nil
+}
+
+func (e *deployKeyExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.DeployKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedDeployKey)
+	}
+
+	// Deploy keys cannot be changed once they are added. Only a generated
+	// key pair whose private key is lost is outdated, it is rotated by
+	// removing the deploy key and adding a new key pair.
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDeployKeyID)
+	}
+	p := cr.Spec.ForProvider
+	_, err = e.gh.DeleteKey(ctx, p.Owner, p.Repository, id)
+	if err := resource.Ignore(ghclient.IsNotFound, err); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteDeployKey)
+	}
+
+	k, conn, err := e.add(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	meta.SetExternalName(cr, strconv.FormatInt(k.GetID(), 10))
+	if err := e.client.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateDeployKey)
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+}
+
+func (e *deployKeyExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DeployKey)
+	if !ok {
+		return errors.New(errUnexpectedDeployKey)
+	}
+
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, errDeployKeyID)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err = e.gh.DeleteKey(ctx, p.Owner, p.Repository, id)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteDeployKey)
+}
+
+// add adds the deploy key, generating a key pair if no public key is given.
+// It returns the connection details to publish, the private key of a
+// generated key pair is never stored elsewhere.
+func (e *deployKeyExternal) add(ctx context.Context, cr *v1alpha1.DeployKey) (*github.Key, managed.ConnectionDetails, error) {
+	p := cr.Spec.ForProvider
+	key := deploykeys.GenerateKey(p)
+
+	conn := managed.ConnectionDetails{}
+	if p.Key == nil {
+		if cr.GetWriteConnectionSecretToReference() == nil {
+			return nil, nil, errors.New(errNoConnectionSecret)
+		}
+		pub, priv, err := deploykeys.GenerateKeyPair(e.rand)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errGenerateKeyPair)
+		}
+		key.Key = github.String(string(pub))
+		conn[connectionKeyPrivateKey] = priv
+	}
+
+	k, _, err := e.gh.CreateKey(ctx, p.Owner, p.Repository, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errCreateDeployKey)
+	}
+	conn[connectionKeyPublicKey] = []byte(key.GetKey())
+	return k, conn, nil
+}
+
+// isPrivateKeyPublished checks whether the connection Secret of the supplied
+// DeployKey holds a private key.
+func (e *deployKeyExternal) isPrivateKeyPublished(ctx context.Context, cr *v1alpha1.DeployKey) (bool, error) {
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return false, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.reader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return false, resource.Ignore(kerrors.IsNotFound, err)
+	}
+	return len(s.Data[connectionKeyPrivateKey]) > 0, nil
+}
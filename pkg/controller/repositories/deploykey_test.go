@@ -0,0 +1,533 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/deploykeys"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeDeployKeyID = int64(42)
+	fakePublicKey   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+)
+
+func newDeployKey(externalName string, key *string) *v1alpha1.DeployKey {
+	r := &v1alpha1.DeployKey{}
+	meta.SetExternalName(r, externalName)
+	r.Spec.ForProvider = v1alpha1.DeployKeyParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		Title:      "argocd",
+		Key:        key,
+		ReadOnly:   &fakeTrue,
+	}
+	return r
+}
+
+func withConnectionSecret(r *v1alpha1.DeployKey) *v1alpha1.DeployKey {
+	r.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "argocd", Namespace: "crossplane-system"})
+	return r
+}
+
+func connectionSecret(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		if key.Name != "argocd" || key.Namespace != "crossplane-system" {
+			return errBoom
+		}
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func observedDeployKey() *github.Key {
+	return &github.Key{
+		ID:       &fakeDeployKeyID,
+		Title:    github.String("argocd"),
+		Key:      &fakePublicKey,
+		ReadOnly: &fakeTrue,
+	}
+}
+
+type deployKeyArgs struct {
+	kube   client.Client
+	reader client.Reader
+	mg     resource.Managed
+	github deploykeys.Service
+}
+
+func TestDeployKeyObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		key *string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   deployKeyArgs
+		want   want
+	}{
+		"ResourceIsNotDeployKey": {
+			reason: "Must return an error if the resource is not a DeployKey",
+			args: deployKeyArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedDeployKey),
+			},
+		},
+		"NoExternalName": {
+			reason: "Must return ResourceExists as false if the deploy key was not added yet",
+			args: deployKeyArgs{
+				mg: newDeployKey("", nil),
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"CannotGetDeployKey": {
+			reason: "Must return an error if GET deploy key fails and the error is not 404",
+			args: deployKeyArgs{
+				mg: newDeployKey("42", &fakePublicKey),
+				github: &fake.MockDeployKeyService{
+					MockGetKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				key: &fakePublicKey,
+				err: errors.Wrap(errBoom, errGetDeployKey),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the deploy key does not exist",
+			args: deployKeyArgs{
+				mg: newDeployKey("42", &fakePublicKey),
+				github: &fake.MockDeployKeyService{
+					MockGetKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				key: &fakePublicKey,
+				eo:  managed.ExternalObservation{},
+			},
+		},
+		"GeneratedKey": {
+			reason: "Must not late initialize the public key of a generated key pair whose private key is published",
+			args: deployKeyArgs{
+				reader: &test.MockClient{MockGet: connectionSecret(map[string][]byte{connectionKeyPrivateKey: []byte("private")})},
+				mg:     withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockGetKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+						if id != fakeDeployKeyID {
+							return nil, nil, errNotFound
+						}
+						return observedDeployKey(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{connectionKeyPublicKey: []byte(fakePublicKey)},
+				},
+			},
+		},
+		"CannotGetConnectionSecret": {
+			reason: "Must return an error if the connection Secret of a generated key pair cannot be read",
+			args: deployKeyArgs{
+				reader: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg:     withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockGetKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+						return observedDeployKey(), nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetConnectionSecret),
+			},
+		},
+		"StaleCache": {
+			reason: "Must read the connection Secret bypassing the cache, which may not hold the private key published by Create yet",
+			args: deployKeyArgs{
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "argocd"))},
+				reader: &test.MockClient{MockGet: connectionSecret(map[string][]byte{connectionKeyPrivateKey: []byte("private")})},
+				mg:     withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockGetKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+						return observedDeployKey(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{connectionKeyPublicKey: []byte(fakePublicKey)},
+				},
+			},
+		},
+		"PrivateKeyLost": {
+			reason: "Must return ResourceUpToDate as false without removing the deploy key if the private key of a generated key pair is not published",
+			args: deployKeyArgs{
+				reader: &test.MockClient{MockGet: connectionSecret(map[string][]byte{connectionKeyPublicKey: []byte(fakePublicKey)})},
+				mg:     withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockGetKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+						return observedDeployKey(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{connectionKeyPublicKey: []byte(fakePublicKey)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := deployKeyExternal{gh: tc.args.github, client: tc.args.kube, reader: tc.args.reader}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.DeployKey); ok {
+				if diff := cmp.Diff(tc.want.key, cr.Spec.ForProvider.Key); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want key, +got key:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		privateKey   bool
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   deployKeyArgs
+		want   want
+	}{
+		"ResourceIsNotDeployKey": {
+			reason: "Must return an error if the resource is not a DeployKey",
+			args: deployKeyArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedDeployKey),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the deploy key cannot be added",
+			args: deployKeyArgs{
+				mg: newDeployKey("", &fakePublicKey),
+				github: &fake.MockDeployKeyService{
+					MockCreateKey: func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateDeployKey),
+			},
+		},
+		"GivenKey": {
+			reason: "Must add the given public key and publish no private key",
+			args: deployKeyArgs{
+				mg: newDeployKey("", &fakePublicKey),
+				github: &fake.MockDeployKeyService{
+					MockCreateKey: func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+						if key.GetKey() != fakePublicKey {
+							return nil, nil, errBoom
+						}
+						return observedDeployKey(), nil, nil
+					},
+				},
+			},
+			want: want{
+				externalName: "42",
+			},
+		},
+		"NoConnectionSecret": {
+			reason: "Must not generate a key pair if its private key cannot be published",
+			args: deployKeyArgs{
+				mg: newDeployKey("", nil),
+			},
+			want: want{
+				err: errors.New(errNoConnectionSecret),
+			},
+		},
+		"GeneratedKey": {
+			reason: "Must add the public key of a generated key pair and publish its private key",
+			args: deployKeyArgs{
+				mg: withConnectionSecret(newDeployKey("", nil)),
+				github: &fake.MockDeployKeyService{
+					MockCreateKey: func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+						if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.GetKey())); err != nil {
+							return nil, nil, err
+						}
+						return observedDeployKey(), nil, nil
+					},
+				},
+			},
+			want: want{
+				externalName: "42",
+				privateKey:   true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := deployKeyExternal{gh: tc.args.github, rand: rand.Reader}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+			if !got.ExternalNameAssigned {
+				t.Errorf("\n%s\nCreate(...): want ExternalNameAssigned", tc.reason)
+			}
+			priv, ok := got.ConnectionDetails[connectionKeyPrivateKey]
+			if ok != tc.want.privateKey {
+				t.Fatalf("\n%s\nCreate(...): want private key published: %t, got %t", tc.reason, tc.want.privateKey, ok)
+			}
+			if !ok {
+				return
+			}
+			signer, err := ssh.ParsePrivateKey(priv)
+			if err != nil {
+				t.Fatalf("\n%s\nCreate(...): cannot parse published private key: %v", tc.reason, err)
+			}
+			pub, _, _, _, err := ssh.ParseAuthorizedKey(got.ConnectionDetails[connectionKeyPublicKey])
+			if err != nil {
+				t.Fatalf("\n%s\nCreate(...): cannot parse published public key: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(pub.Marshal(), signer.PublicKey().Marshal()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -published public key, +public key of private key:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeployKeyUpdate(t *testing.T) {
+	type want struct {
+		externalName string
+		privateKey   bool
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   deployKeyArgs
+		want   want
+	}{
+		"ResourceIsNotDeployKey": {
+			reason: "Must return an error if the resource is not a DeployKey",
+			args: deployKeyArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedDeployKey),
+			},
+		},
+		"CannotRemoveLostKey": {
+			reason: "Must return an error if the deploy key whose private key is lost cannot be removed",
+			args: deployKeyArgs{
+				mg: withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockDeleteKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				externalName: "42",
+				err:          errors.Wrap(errBoom, errDeleteDeployKey),
+			},
+		},
+		"CannotAddKey": {
+			reason: "Must return an error if the new deploy key cannot be added",
+			args: deployKeyArgs{
+				mg: withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockDeleteKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+						return nil, nil
+					},
+					MockCreateKey: func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				externalName: "42",
+				err:          errors.Wrap(errBoom, errCreateDeployKey),
+			},
+		},
+		"KubeUpdateFailed": {
+			reason: "Must return an error if the ID of the new deploy key cannot be recorded",
+			args: deployKeyArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockDeleteKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+						return nil, nil
+					},
+					MockCreateKey: func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+						return &github.Key{ID: github.Int64(43)}, nil, nil
+					},
+				},
+			},
+			want: want{
+				externalName: "43",
+				err:          errors.Wrap(errBoom, errKubeUpdateDeployKey),
+			},
+		},
+		"Rotated": {
+			reason: "Must remove the deploy key whose private key is lost, add a new key pair and publish its private key",
+			args: deployKeyArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg:   withConnectionSecret(newDeployKey("42", nil)),
+				github: &fake.MockDeployKeyService{
+					MockDeleteKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+						if id != fakeDeployKeyID {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+					MockCreateKey: func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+						return &github.Key{ID: github.Int64(43)}, nil, nil
+					},
+				},
+			},
+			want: want{
+				externalName: "43",
+				privateKey:   true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := deployKeyExternal{gh: tc.args.github, client: tc.args.kube, rand: rand.Reader}
+			got, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if _, ok := tc.args.mg.(*v1alpha1.DeployKey); !ok {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+			if _, ok := got.ConnectionDetails[connectionKeyPrivateKey]; ok != tc.want.privateKey {
+				t.Errorf("\n%s\nUpdate(...): want private key published: %t, got %t", tc.reason, tc.want.privateKey, ok)
+			}
+		})
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   deployKeyArgs
+		want   error
+	}{
+		"ResourceIsNotDeployKey": {
+			reason: "Must return an error if the resource is not a DeployKey",
+			args: deployKeyArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedDeployKey),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the deploy key cannot be deleted",
+			args: deployKeyArgs{
+				mg: newDeployKey("42", &fakePublicKey),
+				github: &fake.MockDeployKeyService{
+					MockDeleteKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteDeployKey),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the deploy key no longer exists",
+			args: deployKeyArgs{
+				mg: newDeployKey("42", &fakePublicKey),
+				github: &fake.MockDeployKeyService{
+					MockDeleteKey: func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := deployKeyExternal{gh: tc.args.github}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
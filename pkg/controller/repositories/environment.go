@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/environments"
+)
+
+const (
+	errUnexpectedEnvironment   = "The managed resource is not an Environment resource"
+	errGetEnvironment          = "cannot get Environment"
+	errGetEnvironmentReviewers = "cannot get reviewers of Environment"
+	errListBranchPolicies      = "cannot list deployment branch policies of Environment"
+	errCreateBranchPolicy      = "cannot create deployment branch policy of Environment"
+	errDeleteBranchPolicy      = "cannot delete deployment branch policy of Environment"
+	errCreateEnvironment       = "cannot create Environment"
+	errUpdateEnvironment       = "cannot update Environment"
+	errDeleteEnvironment       = "cannot delete Environment"
+	errKubeUpdateEnvironment   = "cannot update Environment custom resource"
+)
+
+// SetupEnvironment adds a controller that reconciles Environments.
+func SetupEnvironment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.EnvironmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Environment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnvironmentGroupVersionKind),
+			managed.WithExternalConnecter(&environmentConnector{client: mgr.GetClient(), newClientFn: environments.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type environmentConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*environments.Service, error)
+}
+
+func (c *environmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return nil, errors.New(errUnexpectedEnvironment)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &environmentExternal{*gh, c.client}, nil
+}
+
+type environmentExternal struct {
+	gh     environments.Service
+	client client.Client
+}
+
+func (e *environmentExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedEnvironment)
+	}
+
+	p := cr.Spec.ForProvider
+	env, _, err := e.gh.GetEnvironment(ctx, p.Owner, p.Repository, p.Name)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEnvironment)
+	}
+
+	var policies []*environments.DeploymentBranchPolicy
+	if environments.HasCustomBranchPolicies(env) {
+		policies, err = environments.ListBranchPolicies(ctx, e.gh, p.Owner, p.Repository, p.Name)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListBranchPolicies)
+		}
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	environments.LateInitialize(&cr.Spec.ForProvider, env)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateEnvironment)
+		}
+		lateInit = true
+	}
+
+	cr.Status.AtProvider = environments.GenerateObservation(env)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate:        environments.IsUpToDate(cr.Spec.ForProvider, env, policies),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *environmentExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedEnvironment)
+	}
+
+	if err := e.put(ctx, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEnvironment)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *environmentExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedEnvironment)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.put(ctx, cr.Spec.ForProvider), errUpdateEnvironment)
+}
+
+// put creates or updates the environment and then its deployment branch
+// policies, which can only be added once custom policies are enabled.
+func (e *environmentExternal) put(ctx context.Context, p v1alpha1.EnvironmentParameters) error {
+	reviewers, err := environments.GenerateReviewers(ctx, e.gh, p)
+	if err != nil {
+		return errors.Wrap(err, errGetEnvironmentReviewers)
+	}
+	if _, _, err := e.gh.CreateUpdateEnvironment(ctx, p.Owner, p.Repository, p.Name, environments.GenerateCreateUpdateEnvironment(p, reviewers)); err != nil {
+		return err
+	}
+	if p.DeploymentBranchPolicy == nil || p.DeploymentBranchPolicy.ProtectedBranches {
+		return nil
+	}
+
+	policies, err := environments.ListBranchPolicies(ctx, e.gh, p.Owner, p.Repository, p.Name)
+	if err != nil {
+		return errors.Wrap(err, errListBranchPolicies)
+	}
+	create, remove := environments.DiffBranchPolicies(p.DeploymentBranchPolicy.Patterns, policies)
+	for _, bp := range remove {
+		if _, err := e.gh.DeleteDeploymentBranchPolicy(ctx, p.Owner, p.Repository, p.Name, bp.ID); resource.Ignore(ghclient.IsNotFound, err) != nil {
+			return errors.Wrap(err, errDeleteBranchPolicy)
+		}
+	}
+	for _, bp := range create {
+		if _, _, err := e.gh.CreateDeploymentBranchPolicy(ctx, p.Owner, p.Repository, p.Name, bp); err != nil {
+			return errors.Wrap(err, errCreateBranchPolicy)
+		}
+	}
+	return nil
+}
+
+func (e *environmentExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Environment)
+	if !ok {
+		return errors.New(errUnexpectedEnvironment)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.DeleteEnvironment(ctx, p.Owner, p.Repository, p.Name)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteEnvironment)
+}
@@ -0,0 +1,553 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/environments"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeReviewer     = "octocat"
+	fakeReviewerID   = int64(583231)
+	fakeReviewerTeam = "release-managers"
+	fakeTeamID       = int64(42)
+	fakePattern      = "release/*"
+)
+
+type environmentArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github environments.Service
+}
+
+func newEnvironment() *v1alpha1.Environment {
+	r := &v1alpha1.Environment{}
+	r.Spec.ForProvider = v1alpha1.EnvironmentParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		Name:       fakeEnvironment,
+		WaitTimer:  github.Int(30),
+		Reviewers: []v1alpha1.EnvironmentReviewer{
+			{User: &fakeReviewer},
+			{Team: &fakeReviewerTeam},
+		},
+		PreventSelfReview: github.Bool(true),
+		DeploymentBranchPolicy: &v1alpha1.DeploymentBranchPolicy{
+			Patterns: []v1alpha1.DeploymentBranchPattern{{Name: fakePattern}},
+		},
+	}
+	return r
+}
+
+func observedEnvironment() *environments.Environment {
+	return &environments.Environment{
+		ID:   1,
+		Name: fakeEnvironment,
+		ProtectionRules: []*environments.ProtectionRule{
+			{Type: environments.RuleTypeWaitTimer, WaitTimer: 30},
+			{
+				Type:              environments.RuleTypeRequiredReviewers,
+				PreventSelfReview: true,
+				Reviewers: []*environments.Reviewer{
+					{Type: environments.ReviewerTypeUser, Reviewer: &environments.ReviewerAccount{ID: fakeReviewerID, Login: "Octocat"}},
+					{Type: environments.ReviewerTypeTeam, Reviewer: &environments.ReviewerAccount{ID: fakeTeamID, Slug: fakeReviewerTeam}},
+				},
+			},
+		},
+		DeploymentBranchPolicy: &environments.BranchPolicy{CustomBranchPolicies: true},
+	}
+}
+
+func branchPolicies(policies ...*environments.DeploymentBranchPolicy) func(ctx context.Context, owner, repo, env string, opts *github.ListOptions) (*environments.DeploymentBranchPolicyList, *github.Response, error) {
+	return func(ctx context.Context, owner, repo, env string, opts *github.ListOptions) (*environments.DeploymentBranchPolicyList, *github.Response, error) {
+		return &environments.DeploymentBranchPolicyList{TotalCount: len(policies), BranchPolicies: policies}, &github.Response{}, nil
+	}
+}
+
+func lookupReviewers(m *fake.MockEnvironmentService) *fake.MockEnvironmentService {
+	m.MockGetUser = func(ctx context.Context, user string) (*github.User, *github.Response, error) {
+		return &github.User{ID: &fakeReviewerID, Login: &user}, nil, nil
+	}
+	m.MockGetTeamBySlug = func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+		if org != fakeOwner {
+			return nil, nil, errNotFound
+		}
+		return &github.Team{ID: &fakeTeamID, Slug: &slug}, nil, nil
+	}
+	return m
+}
+
+func TestEnvironmentObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironment": {
+			reason: "Must return an error if the resource is not an Environment",
+			args: environmentArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironment),
+			},
+		},
+		"CannotGetEnvironment": {
+			reason: "Must return an error if GET environment fails and the error is not 404",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetEnvironment),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the environment does not exist",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"CannotListBranchPolicies": {
+			reason: "Must return an error if the deployment branch policies cannot be listed",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: func(ctx context.Context, owner, repo, env string, opts *github.ListOptions) (*environments.DeploymentBranchPolicyList, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errListBranchPolicies),
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the environment and its branch policies did not change",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(&environments.DeploymentBranchPolicy{ID: 1, Name: fakePattern, Type: environments.PatternTypeBranch}),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ReviewersChanged": {
+			reason: "Must return ResourceUpToDate as false if a reviewer was removed",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+						e := observedEnvironment()
+						e.ProtectionRules[1].Reviewers = e.ProtectionRules[1].Reviewers[:1]
+						return e, nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(&environments.DeploymentBranchPolicy{ID: 1, Name: fakePattern}),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"BranchPoliciesChanged": {
+			reason: "Must return ResourceUpToDate as false if a branch policy that is not given was added",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(
+						&environments.DeploymentBranchPolicy{ID: 1, Name: fakePattern},
+						&environments.DeploymentBranchPolicy{ID: 2, Name: "v*", Type: "tag"},
+					),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			reason: "Must late initialize the wait timer and prevent self-review",
+			args: environmentArgs{
+				mg: func() *v1alpha1.Environment {
+					cr := newEnvironment()
+					cr.Spec.ForProvider.WaitTimer = nil
+					cr.Spec.ForProvider.PreventSelfReview = nil
+					return cr
+				}(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				github: &fake.MockEnvironmentService{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(&environments.DeploymentBranchPolicy{ID: 1, Name: fakePattern}),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentCreate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironment": {
+			reason: "Must return an error if the resource is not an Environment",
+			args: environmentArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironment),
+			},
+		},
+		"CannotGetReviewers": {
+			reason: "Must return an error if a reviewer cannot be looked up",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockGetUser: func(ctx context.Context, user string) (*github.User, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errGetEnvironmentReviewers), errCreateEnvironment),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the environment cannot be created",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: lookupReviewers(&fake.MockEnvironmentService{
+					MockCreateUpdateEnvironment: func(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateEnvironment),
+			},
+		},
+		"CannotCreateBranchPolicy": {
+			reason: "Must return an error if a deployment branch policy cannot be created",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: lookupReviewers(&fake.MockEnvironmentService{
+					MockCreateUpdateEnvironment: func(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error) {
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(),
+					MockCreateDeploymentBranchPolicy: func(ctx context.Context, owner, repo, env string, p *environments.DeploymentBranchPolicy) (*environments.DeploymentBranchPolicy, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errCreateBranchPolicy), errCreateEnvironment),
+			},
+		},
+		"Success": {
+			reason: "Must create the environment with its reviewers and then its branch policies",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: lookupReviewers(&fake.MockEnvironmentService{
+					MockCreateUpdateEnvironment: func(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error) {
+						want := &environments.CreateUpdateEnvironment{
+							WaitTimer:         github.Int(30),
+							PreventSelfReview: github.Bool(true),
+							Reviewers: []*environments.EnvironmentReviewer{
+								{Type: environments.ReviewerTypeUser, ID: fakeReviewerID},
+								{Type: environments.ReviewerTypeTeam, ID: fakeTeamID},
+							},
+							DeploymentBranchPolicy: &environments.BranchPolicy{CustomBranchPolicies: true},
+						}
+						if name != fakeEnvironment || !cmp.Equal(want, e) {
+							return nil, nil, errBoom
+						}
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(),
+					MockCreateDeploymentBranchPolicy: func(ctx context.Context, owner, repo, env string, p *environments.DeploymentBranchPolicy) (*environments.DeploymentBranchPolicy, *github.Response, error) {
+						if p.Name != fakePattern || p.Type != environments.PatternTypeBranch {
+							return nil, nil, errBoom
+						}
+						return p, nil, nil
+					},
+				}),
+			},
+			want: want{
+				eo: managed.ExternalCreation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentUpdate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironment": {
+			reason: "Must return an error if the resource is not an Environment",
+			args: environmentArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironment),
+			},
+		},
+		"CannotDeleteBranchPolicy": {
+			reason: "Must return an error if a deployment branch policy cannot be deleted",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: lookupReviewers(&fake.MockEnvironmentService{
+					MockCreateUpdateEnvironment: func(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error) {
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(&environments.DeploymentBranchPolicy{ID: 2, Name: "main"}),
+					MockDeleteDeploymentBranchPolicy: func(ctx context.Context, owner, repo, env string, id int64) (*github.Response, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errDeleteBranchPolicy), errUpdateEnvironment),
+			},
+		},
+		"ProtectedBranches": {
+			reason: "Must not sync branch policies if only protected branches can deploy",
+			args: environmentArgs{
+				mg: func() *v1alpha1.Environment {
+					cr := newEnvironment()
+					cr.Spec.ForProvider.Reviewers = nil
+					cr.Spec.ForProvider.DeploymentBranchPolicy = &v1alpha1.DeploymentBranchPolicy{ProtectedBranches: true}
+					return cr
+				}(),
+				github: &fake.MockEnvironmentService{
+					MockCreateUpdateEnvironment: func(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error) {
+						want := &environments.CreateUpdateEnvironment{
+							WaitTimer:              github.Int(30),
+							DeploymentBranchPolicy: &environments.BranchPolicy{ProtectedBranches: true},
+						}
+						if diff := cmp.Diff(want, e); diff != "" {
+							return nil, nil, errors.New(diff)
+						}
+						return &environments.Environment{}, nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalUpdate{},
+			},
+		},
+		"Success": {
+			reason: "Must update the environment and replace the branch policies that are not given",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: lookupReviewers(&fake.MockEnvironmentService{
+					MockCreateUpdateEnvironment: func(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error) {
+						return observedEnvironment(), nil, nil
+					},
+					MockListDeploymentBranchPolicies: branchPolicies(&environments.DeploymentBranchPolicy{ID: 2, Name: "main", Type: environments.PatternTypeBranch}),
+					MockDeleteDeploymentBranchPolicy: func(ctx context.Context, owner, repo, env string, id int64) (*github.Response, error) {
+						if id != 2 {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+					MockCreateDeploymentBranchPolicy: func(ctx context.Context, owner, repo, env string, p *environments.DeploymentBranchPolicy) (*environments.DeploymentBranchPolicy, *github.Response, error) {
+						if p.Name != fakePattern {
+							return nil, nil, errBoom
+						}
+						return p, nil, nil
+					},
+				}),
+			},
+			want: want{
+				eo: managed.ExternalUpdate{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironment": {
+			reason: "Must return an error if the resource is not an Environment",
+			args: environmentArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironment),
+			},
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the environment cannot be deleted",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockDeleteEnvironment: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteEnvironment),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the environment was already deleted",
+			args: environmentArgs{
+				mg: newEnvironment(),
+				github: &fake.MockEnvironmentService{
+					MockDeleteEnvironment: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentExternal{gh: tc.args.github, client: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+)
+
+const (
+	errUnexpectedEnvironmentSecret = "The managed resource is not an EnvironmentSecret resource"
+	errGetEnvironmentSecretValue   = "cannot get value of EnvironmentSecret"
+	errGetEnvironmentPublicKey     = "cannot get public key of environment to encrypt EnvironmentSecret"
+	errEncryptEnvironmentSecret    = "cannot encrypt EnvironmentSecret"
+	errGetEnvironmentSecret        = "cannot get EnvironmentSecret"
+	errCreateEnvironmentSecret     = "cannot create EnvironmentSecret"
+	errUpdateEnvironmentSecret     = "cannot update EnvironmentSecret"
+	errDeleteEnvironmentSecret     = "cannot delete EnvironmentSecret"
+	errKubeUpdateEnvironmentSecret = "cannot update EnvironmentSecret custom resource"
+)
+
+// SetupEnvironmentSecret adds a controller that reconciles
+// EnvironmentSecrets.
+func SetupEnvironmentSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.EnvironmentSecretGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.EnvironmentSecret{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnvironmentSecretGroupVersionKind),
+			managed.WithExternalConnecter(&environmentSecretConnector{client: mgr.GetClient(), newClientFn: secretstores.NewEnvironmentService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type environmentSecretConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*secretstores.EnvironmentService, error)
+}
+
+func (c *environmentSecretConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.EnvironmentSecret)
+	if !ok {
+		return nil, errors.New(errUnexpectedEnvironmentSecret)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &environmentSecretExternal{*gh, c.client}, nil
+}
+
+type environmentSecretExternal struct {
+	gh     secretstores.EnvironmentService
+	client client.Client
+}
+
+func (e *environmentSecretExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.EnvironmentSecret)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedEnvironmentSecret)
+	}
+
+	p := cr.Spec.ForProvider
+	id := ghclient.Int64Value(p.RepositoryID)
+	s, _, err := e.gh.GetEnvSecret(ctx, id, p.Environment, p.Name)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEnvironmentSecret)
+	}
+
+	v, err := ghclient.GetSecretValue(ctx, e.client, p.ValueSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEnvironmentSecretValue)
+	}
+	key, _, err := e.gh.GetEnvPublicKey(ctx, id, p.Environment)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEnvironmentPublicKey)
+	}
+
+	cr.Status.AtProvider = secretstores.GenerateEnvObservation(s)
+	cr.SetConditions(xpv1.Available())
+
+	// The value is sent again if it changed, or if it was encrypted with a
+	// public key of the environment that was rotated since.
+	return managed.ExternalObservation{
+		ResourceUpToDate: ghclient.IsEncryptedSecretUpToDate(cr, v.Version, key.GetKeyID()),
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *environmentSecretExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.EnvironmentSecret)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedEnvironmentSecret)
+	}
+
+	if err := e.put(ctx, cr, errCreateEnvironmentSecret); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *environmentSecretExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.EnvironmentSecret)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedEnvironmentSecret)
+	}
+
+	return managed.ExternalUpdate{}, e.put(ctx, cr, errUpdateEnvironmentSecret)
+}
+
+func (e *environmentSecretExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.EnvironmentSecret)
+	if !ok {
+		return errors.New(errUnexpectedEnvironmentSecret)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.DeleteEnvSecret(ctx, ghclient.Int64Value(p.RepositoryID), p.Environment, p.Name)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteEnvironmentSecret)
+}
+
+// put encrypts the value of the secret with the current public key of the
+// environment and sends it to GitHub. The version of the value and the ID of
+// the key are recorded in the annotations of the EnvironmentSecret. An error
+// sending the secret is wrapped with errPut.
+func (e *environmentSecretExternal) put(ctx context.Context, cr *v1alpha1.EnvironmentSecret, errPut string) error {
+	p := cr.Spec.ForProvider
+	id := ghclient.Int64Value(p.RepositoryID)
+	v, err := ghclient.GetSecretValue(ctx, e.client, p.ValueSecretRef)
+	if err != nil {
+		return errors.Wrap(err, errGetEnvironmentSecretValue)
+	}
+	key, _, err := e.gh.GetEnvPublicKey(ctx, id, p.Environment)
+	if err != nil {
+		return errors.Wrap(err, errGetEnvironmentPublicKey)
+	}
+	s, err := ghclient.EncryptSecret(p.Name, key, v.Value)
+	if err != nil {
+		return errors.Wrap(err, errEncryptEnvironmentSecret)
+	}
+	if _, err := e.gh.CreateOrUpdateEnvSecret(ctx, id, p.Environment, s); err != nil {
+		return errors.Wrap(err, errPut)
+	}
+
+	ghclient.SetEncryptedSecret(cr, v.Version, key.GetKeyID())
+	return errors.Wrap(e.client.Update(ctx, cr), errKubeUpdateEnvironmentSecret)
+}
@@ -0,0 +1,475 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/box"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var fakeRepositoryID = int64(1296269)
+
+func newEnvironmentSecret(encryptedWith ...string) *v1alpha1.EnvironmentSecret {
+	r := &v1alpha1.EnvironmentSecret{}
+	r.Spec.ForProvider = v1alpha1.EnvironmentSecretParameters{
+		RepositoryID: &fakeRepositoryID,
+		Environment:  fakeEnvironment,
+		Name:         fakeSecretName,
+		ValueSecretRef: xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "deploy-token", Namespace: "crossplane-system"},
+			Key:             "secret",
+		},
+	}
+	if len(encryptedWith) == 2 {
+		ghclient.SetEncryptedSecret(r, secretVersion(encryptedWith[0]), encryptedWith[1])
+	}
+	return r
+}
+
+func envPublicKey(keyID string) func(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error) {
+	return func(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error) {
+		if repoID != fakeRepositoryID || env != fakeEnvironment {
+			return nil, nil, errNotFound
+		}
+		return &github.PublicKey{
+			KeyID: github.String(keyID),
+			Key:   github.String(base64.StdEncoding.EncodeToString(fakeBoxPublicKey[:])),
+		}, nil, nil
+	}
+}
+
+func observedEnvironmentSecret(ctx context.Context, repoID int64, env, name string) (*github.Secret, *github.Response, error) {
+	return &github.Secret{Name: name}, nil, nil
+}
+
+type environmentSecretArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github secretstores.EnvironmentService
+}
+
+func TestEnvironmentSecretObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentSecretArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironmentSecret": {
+			reason: "Must return an error if the resource is not an EnvironmentSecret",
+			args: environmentSecretArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironmentSecret),
+			},
+		},
+		"CannotGetEnvironmentSecret": {
+			reason: "Must return an error if GET secret fails and the error is not 404",
+			args: environmentSecretArgs{
+				mg: newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvSecret: func(ctx context.Context, repoID int64, env, name string) (*github.Secret, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetEnvironmentSecret),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the secret does not exist",
+			args: environmentSecretArgs{
+				mg: newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvSecret: func(ctx context.Context, repoID int64, env, name string) (*github.Secret, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"CannotGetValue": {
+			reason: "Must return an error if the referenced Secret cannot be read",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg:   newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvSecret: observedEnvironmentSecret,
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get Secret"), errGetEnvironmentSecretValue),
+			},
+		},
+		"CannotGetPublicKey": {
+			reason: "Must return an error if the public key of the environment cannot be read",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+				mg:   newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvSecret: observedEnvironmentSecret,
+					MockGetEnvPublicKey: func(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetEnvironmentPublicKey),
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if neither the value nor the public key changed",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+				mg:   newEnvironmentSecret(fakeSecretValue, fakePublicKeyID),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvSecret:    observedEnvironmentSecret,
+					MockGetEnvPublicKey: envPublicKey(fakePublicKeyID),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValueChanged": {
+			reason: "Must return ResourceUpToDate as false if the value of the secret changed",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: webhookSecret("rotated")},
+				mg:   newEnvironmentSecret(fakeSecretValue, fakePublicKeyID),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvSecret:    observedEnvironmentSecret,
+					MockGetEnvPublicKey: envPublicKey(fakePublicKeyID),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PublicKeyRotated": {
+			reason: "Must return ResourceUpToDate as false if the public key of the environment was rotated",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+				mg:   newEnvironmentSecret(fakeSecretValue, fakePublicKeyID),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvSecret:    observedEnvironmentSecret,
+					MockGetEnvPublicKey: envPublicKey("1234"),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentSecretExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentSecretCreate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentSecretArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironmentSecret": {
+			reason: "Must return an error if the resource is not an EnvironmentSecret",
+			args: environmentSecretArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironmentSecret),
+			},
+		},
+		"CannotGetPublicKey": {
+			reason: "Must return an error if the public key of the environment cannot be read",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+				mg:   newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvPublicKey: func(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetEnvironmentPublicKey),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the secret cannot be created",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+				mg:   newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvPublicKey: envPublicKey(fakePublicKeyID),
+					MockCreateOrUpdateEnvSecret: func(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateEnvironmentSecret),
+			},
+		},
+		"Success": {
+			reason: "Must send the value encrypted with the public key of the environment",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{
+					MockGet:    webhookSecret(fakeSecretValue),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvPublicKey: envPublicKey(fakePublicKeyID),
+					MockCreateOrUpdateEnvSecret: func(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+						sealed, err := base64.StdEncoding.DecodeString(eSecret.EncryptedValue)
+						if err != nil {
+							return nil, err
+						}
+						v, ok := box.OpenAnonymous(nil, sealed, fakeBoxPublicKey, fakeBoxPrivateKey)
+						if !ok || string(v) != fakeSecretValue || eSecret.KeyID != fakePublicKeyID {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalCreation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentSecretExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentSecretUpdate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentSecretArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironmentSecret": {
+			reason: "Must return an error if the resource is not an EnvironmentSecret",
+			args: environmentSecretArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironmentSecret),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the secret cannot be sent",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+				mg:   newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvPublicKey: envPublicKey(fakePublicKeyID),
+					MockCreateOrUpdateEnvSecret: func(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateEnvironmentSecret),
+			},
+		},
+		"KubeUpdateFailed": {
+			reason: "Must return an error if the version of the value cannot be recorded",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{
+					MockGet:    webhookSecret(fakeSecretValue),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvPublicKey: envPublicKey(fakePublicKeyID),
+					MockCreateOrUpdateEnvSecret: func(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				upToDate: true,
+				err:      errors.Wrap(errBoom, errKubeUpdateEnvironmentSecret),
+			},
+		},
+		"Success": {
+			reason: "Must encrypt the value with the rotated public key and record its ID",
+			args: environmentSecretArgs{
+				kube: &test.MockClient{
+					MockGet:    webhookSecret(fakeSecretValue),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newEnvironmentSecret(fakeSecretValue, "1234"),
+				github: &fake.MockEnvironmentSecretService{
+					MockGetEnvPublicKey: envPublicKey(fakePublicKeyID),
+					MockCreateOrUpdateEnvSecret: func(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+						if eSecret.Name != fakeSecretName || eSecret.KeyID != fakePublicKeyID {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentSecretExternal{gh: tc.args.github, client: tc.args.kube}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.EnvironmentSecret); ok {
+				got := ghclient.IsEncryptedSecretUpToDate(cr, secretVersion(fakeSecretValue), fakePublicKeyID)
+				if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+					t.Errorf("\n%s\nUpdate(...): -want up to date, +got up to date:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEnvironmentSecretDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   environmentSecretArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironmentSecret": {
+			reason: "Must return an error if the resource is not an EnvironmentSecret",
+			args: environmentSecretArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironmentSecret),
+			},
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if DELETE secret fails and the error is not 404",
+			args: environmentSecretArgs{
+				mg: newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockDeleteEnvSecret: func(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteEnvironmentSecret),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the secret was already deleted",
+			args: environmentSecretArgs{
+				mg: newEnvironmentSecret(),
+				github: &fake.MockEnvironmentSecretService{
+					MockDeleteEnvSecret: func(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentSecretExternal{gh: tc.args.github, client: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
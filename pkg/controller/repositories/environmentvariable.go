@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/variables"
+)
+
+const (
+	errUnexpectedEnvironmentVariable = "The managed resource is not an EnvironmentVariable resource"
+	errGetEnvironmentVariableValue   = "cannot get value of EnvironmentVariable"
+	errGetEnvironmentVariable        = "cannot get EnvironmentVariable"
+	errCreateEnvironmentVariable     = "cannot create EnvironmentVariable"
+	errUpdateEnvironmentVariable     = "cannot update EnvironmentVariable"
+	errDeleteEnvironmentVariable     = "cannot delete EnvironmentVariable"
+)
+
+// SetupEnvironmentVariable adds a controller that reconciles
+// EnvironmentVariables.
+func SetupEnvironmentVariable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.EnvironmentVariableGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.EnvironmentVariable{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnvironmentVariableGroupVersionKind),
+			managed.WithExternalConnecter(&environmentVariableConnector{client: mgr.GetClient(), newClientFn: variables.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type environmentVariableConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*variables.Service, error)
+}
+
+func (c *environmentVariableConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.EnvironmentVariable)
+	if !ok {
+		return nil, errors.New(errUnexpectedEnvironmentVariable)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &environmentVariableExternal{*gh, c.client}, nil
+}
+
+type environmentVariableExternal struct {
+	gh     variables.Service
+	client client.Client
+}
+
+func (e *environmentVariableExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.EnvironmentVariable)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedEnvironmentVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	v, _, err := e.gh.GetEnvVariable(ctx, ghclient.Int64Value(p.RepositoryID), p.Environment, p.Name)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEnvironmentVariable)
+	}
+
+	value, err := variables.GetValue(ctx, e.client, p.Value, p.ValueConfigMapRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEnvironmentVariableValue)
+	}
+
+	cr.Status.AtProvider = variables.GenerateEnvObservation(v)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate: v.Value == value,
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *environmentVariableExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.EnvironmentVariable)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedEnvironmentVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	value, err := variables.GetValue(ctx, e.client, p.Value, p.ValueConfigMapRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetEnvironmentVariableValue)
+	}
+	if _, err := e.gh.CreateEnvVariable(ctx, ghclient.Int64Value(p.RepositoryID), p.Environment, &variables.Variable{Name: p.Name, Value: value}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEnvironmentVariable)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *environmentVariableExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.EnvironmentVariable)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedEnvironmentVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	value, err := variables.GetValue(ctx, e.client, p.Value, p.ValueConfigMapRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetEnvironmentVariableValue)
+	}
+	_, err = e.gh.UpdateEnvVariable(ctx, ghclient.Int64Value(p.RepositoryID), p.Environment, &variables.Variable{Name: p.Name, Value: value})
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEnvironmentVariable)
+}
+
+func (e *environmentVariableExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.EnvironmentVariable)
+	if !ok {
+		return errors.New(errUnexpectedEnvironmentVariable)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.DeleteEnvVariable(ctx, ghclient.Int64Value(p.RepositoryID), p.Environment, p.Name)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteEnvironmentVariable)
+}
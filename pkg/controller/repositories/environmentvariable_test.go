@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/variables"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var fakeEnvironment = "production"
+
+func newEnvironmentVariable() *v1alpha1.EnvironmentVariable {
+	r := &v1alpha1.EnvironmentVariable{}
+	r.Spec.ForProvider = v1alpha1.EnvironmentVariableParameters{
+		RepositoryID: &fakeRepositoryID,
+		Environment:  fakeEnvironment,
+		Name:         fakeVariableName,
+		Value:        &fakeVariableValue,
+	}
+	return r
+}
+
+func TestEnvironmentVariableObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironmentVariable": {
+			reason: "Must return an error if the resource is not an EnvironmentVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironmentVariable),
+			},
+		},
+		"CannotGetEnvironmentVariable": {
+			reason: "Must return an error if GET variable fails and the error is not 404",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockGetEnvVariable: func(ctx context.Context, repoID int64, env, name string) (*variables.Variable, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetEnvironmentVariable),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the variable or its environment does not exist",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockGetEnvVariable: func(ctx context.Context, repoID int64, env, name string) (*variables.Variable, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the value of the variable of the environment did not change",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockGetEnvVariable: func(ctx context.Context, repoID int64, env, name string) (*variables.Variable, *github.Response, error) {
+						if env != fakeEnvironment {
+							return nil, nil, errNotFound
+						}
+						return observedVariable(fakeVariableValue), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValueChanged": {
+			reason: "Must return ResourceUpToDate as false if the value of the variable changed",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockGetEnvVariable: func(ctx context.Context, repoID int64, env, name string) (*variables.Variable, *github.Response, error) {
+						return observedVariable("mirror.example.org"), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVariableCreate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironmentVariable": {
+			reason: "Must return an error if the resource is not an EnvironmentVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironmentVariable),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the variable cannot be created",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockCreateEnvVariable: func(ctx context.Context, repoID int64, env string, v *variables.Variable) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateEnvironmentVariable),
+			},
+		},
+		"Success": {
+			reason: "Must create the variable in the environment",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockCreateEnvVariable: func(ctx context.Context, repoID int64, env string, v *variables.Variable) (*github.Response, error) {
+						if repoID != fakeRepositoryID || env != fakeEnvironment || v.Value != fakeVariableValue {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalCreation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVariableDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   actionsVariableArgs
+		want   want
+	}{
+		"ResourceIsNotEnvironmentVariable": {
+			reason: "Must return an error if the resource is not an EnvironmentVariable",
+			args: actionsVariableArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedEnvironmentVariable),
+			},
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if DELETE variable fails and the error is not 404",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockDeleteEnvVariable: func(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteEnvironmentVariable),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the variable was already deleted",
+			args: actionsVariableArgs{
+				mg: newEnvironmentVariable(),
+				github: &fake.MockVariableService{
+					MockDeleteEnvVariable: func(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := environmentVariableExternal{gh: tc.args.github, client: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
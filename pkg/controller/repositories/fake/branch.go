@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/branches"
+)
+
+// This ensures that the mock implements the Service interface
+var _ branches.Service = (*MockBranchService)(nil)
+
+// MockBranchService is a mock implementation of the branches Service
+type MockBranchService struct {
+	MockGetRepository func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error)
+	MockGetRef        func(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error)
+	MockCreateRef     func(ctx context.Context, owner, repo string, ref *github.Reference) (*github.Reference, *github.Response, error)
+	MockDeleteRef     func(ctx context.Context, owner, repo, ref string) (*github.Response, error)
+}
+
+// GetRepository is a fake GetRepository SDK method
+func (m *MockBranchService) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	return m.MockGetRepository(ctx, owner, repo)
+}
+
+// GetRef is a fake GetRef SDK method
+func (m *MockBranchService) GetRef(ctx context.Context, owner, repo, ref string) (*github.Reference, *github.Response, error) {
+	return m.MockGetRef(ctx, owner, repo, ref)
+}
+
+// CreateRef is a fake CreateRef SDK method
+func (m *MockBranchService) CreateRef(ctx context.Context, owner, repo string, ref *github.Reference) (*github.Reference, *github.Response, error) {
+	return m.MockCreateRef(ctx, owner, repo, ref)
+}
+
+// DeleteRef is a fake DeleteRef SDK method
+func (m *MockBranchService) DeleteRef(ctx context.Context, owner, repo, ref string) (*github.Response, error) {
+	return m.MockDeleteRef(ctx, owner, repo, ref)
+}
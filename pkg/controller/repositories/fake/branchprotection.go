@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/branchprotections"
+)
+
+// This ensures that the mock implements the Service interface
+var _ branchprotections.Service = (*MockBranchProtectionService)(nil)
+
+// MockBranchProtectionService is a mock implementation of the
+// branchprotections Service
+type MockBranchProtectionService struct {
+	MockGetBranchProtection    func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error)
+	MockUpdateBranchProtection func(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error)
+	MockRemoveBranchProtection func(ctx context.Context, owner, repo, branch string) (*github.Response, error)
+}
+
+// GetBranchProtection is a fake GetBranchProtection SDK method
+func (m *MockBranchProtectionService) GetBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+	return m.MockGetBranchProtection(ctx, owner, repo, branch)
+}
+
+// UpdateBranchProtection is a fake UpdateBranchProtection SDK method
+func (m *MockBranchProtectionService) UpdateBranchProtection(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error) {
+	return m.MockUpdateBranchProtection(ctx, owner, repo, branch, preq)
+}
+
+// RemoveBranchProtection is a fake RemoveBranchProtection SDK method
+func (m *MockBranchProtectionService) RemoveBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Response, error) {
+	return m.MockRemoveBranchProtection(ctx, owner, repo, branch)
+}
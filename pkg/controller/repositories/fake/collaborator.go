@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/collaborators"
+)
+
+// This ensures that the mock implements the Service interface
+var _ collaborators.Service = (*MockCollaboratorService)(nil)
+
+// MockCollaboratorService is a mock implementation of the collaborators
+// Service
+type MockCollaboratorService struct {
+	MockIsCollaborator     func(ctx context.Context, owner, repo, user string) (bool, *github.Response, error)
+	MockGetPermissionLevel func(ctx context.Context, owner, repo, user string) (*collaborators.PermissionLevel, *github.Response, error)
+	MockAddCollaborator    func(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error)
+	MockRemoveCollaborator func(ctx context.Context, owner, repo, user string) (*github.Response, error)
+	MockListInvitations    func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*collaborators.Invitation, *github.Response, error)
+	MockUpdateInvitation   func(ctx context.Context, owner, repo string, invitationID int64, permissions string) (*github.RepositoryInvitation, *github.Response, error)
+	MockDeleteInvitation   func(ctx context.Context, owner, repo string, invitationID int64) (*github.Response, error)
+}
+
+// IsCollaborator is a fake IsCollaborator SDK method
+func (m *MockCollaboratorService) IsCollaborator(ctx context.Context, owner, repo, user string) (bool, *github.Response, error) {
+	return m.MockIsCollaborator(ctx, owner, repo, user)
+}
+
+// GetPermissionLevel is a fake GetPermissionLevel SDK method
+func (m *MockCollaboratorService) GetPermissionLevel(ctx context.Context, owner, repo, user string) (*collaborators.PermissionLevel, *github.Response, error) {
+	return m.MockGetPermissionLevel(ctx, owner, repo, user)
+}
+
+// AddCollaborator is a fake AddCollaborator SDK method
+func (m *MockCollaboratorService) AddCollaborator(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+	return m.MockAddCollaborator(ctx, owner, repo, user, opts)
+}
+
+// RemoveCollaborator is a fake RemoveCollaborator SDK method
+func (m *MockCollaboratorService) RemoveCollaborator(ctx context.Context, owner, repo, user string) (*github.Response, error) {
+	return m.MockRemoveCollaborator(ctx, owner, repo, user)
+}
+
+// ListInvitations is a fake ListInvitations SDK method
+func (m *MockCollaboratorService) ListInvitations(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*collaborators.Invitation, *github.Response, error) {
+	return m.MockListInvitations(ctx, owner, repo, opts)
+}
+
+// UpdateInvitation is a fake UpdateInvitation SDK method
+func (m *MockCollaboratorService) UpdateInvitation(ctx context.Context, owner, repo string, invitationID int64, permissions string) (*github.RepositoryInvitation, *github.Response, error) {
+	return m.MockUpdateInvitation(ctx, owner, repo, invitationID, permissions)
+}
+
+// DeleteInvitation is a fake DeleteInvitation SDK method
+func (m *MockCollaboratorService) DeleteInvitation(ctx context.Context, owner, repo string, invitationID int64) (*github.Response, error) {
+	return m.MockDeleteInvitation(ctx, owner, repo, invitationID)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/deploykeys"
+)
+
+// This ensures that the mock implements the Service interface
+var _ deploykeys.Service = (*MockDeployKeyService)(nil)
+
+// MockDeployKeyService is a mock implementation of the deploykeys Service
+type MockDeployKeyService struct {
+	MockGetKey    func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error)
+	MockCreateKey func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error)
+	MockDeleteKey func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+}
+
+// GetKey is a fake GetKey SDK method
+func (m *MockDeployKeyService) GetKey(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+	return m.MockGetKey(ctx, owner, repo, id)
+}
+
+// CreateKey is a fake CreateKey SDK method
+func (m *MockDeployKeyService) CreateKey(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+	return m.MockCreateKey(ctx, owner, repo, key)
+}
+
+// DeleteKey is a fake DeleteKey SDK method
+func (m *MockDeployKeyService) DeleteKey(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return m.MockDeleteKey(ctx, owner, repo, id)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/environments"
+)
+
+// This ensures that the mock implements the Service interface
+var _ environments.Service = (*MockEnvironmentService)(nil)
+
+// MockEnvironmentService is a mock implementation of the environments Service
+type MockEnvironmentService struct {
+	MockGetEnvironment               func(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error)
+	MockCreateUpdateEnvironment      func(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error)
+	MockDeleteEnvironment            func(ctx context.Context, owner, repo, name string) (*github.Response, error)
+	MockListDeploymentBranchPolicies func(ctx context.Context, owner, repo, env string, opts *github.ListOptions) (*environments.DeploymentBranchPolicyList, *github.Response, error)
+	MockCreateDeploymentBranchPolicy func(ctx context.Context, owner, repo, env string, p *environments.DeploymentBranchPolicy) (*environments.DeploymentBranchPolicy, *github.Response, error)
+	MockDeleteDeploymentBranchPolicy func(ctx context.Context, owner, repo, env string, id int64) (*github.Response, error)
+	MockGetUser                      func(ctx context.Context, user string) (*github.User, *github.Response, error)
+	MockGetTeamBySlug                func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error)
+}
+
+// GetEnvironment is a fake GetEnvironment SDK method
+func (m *MockEnvironmentService) GetEnvironment(ctx context.Context, owner, repo, name string) (*environments.Environment, *github.Response, error) {
+	return m.MockGetEnvironment(ctx, owner, repo, name)
+}
+
+// CreateUpdateEnvironment is a fake CreateUpdateEnvironment SDK method
+func (m *MockEnvironmentService) CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, e *environments.CreateUpdateEnvironment) (*environments.Environment, *github.Response, error) {
+	return m.MockCreateUpdateEnvironment(ctx, owner, repo, name, e)
+}
+
+// DeleteEnvironment is a fake DeleteEnvironment SDK method
+func (m *MockEnvironmentService) DeleteEnvironment(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	return m.MockDeleteEnvironment(ctx, owner, repo, name)
+}
+
+// ListDeploymentBranchPolicies is a fake ListDeploymentBranchPolicies SDK method
+func (m *MockEnvironmentService) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, env string, opts *github.ListOptions) (*environments.DeploymentBranchPolicyList, *github.Response, error) {
+	return m.MockListDeploymentBranchPolicies(ctx, owner, repo, env, opts)
+}
+
+// CreateDeploymentBranchPolicy is a fake CreateDeploymentBranchPolicy SDK method
+func (m *MockEnvironmentService) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, env string, p *environments.DeploymentBranchPolicy) (*environments.DeploymentBranchPolicy, *github.Response, error) {
+	return m.MockCreateDeploymentBranchPolicy(ctx, owner, repo, env, p)
+}
+
+// DeleteDeploymentBranchPolicy is a fake DeleteDeploymentBranchPolicy SDK method
+func (m *MockEnvironmentService) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, env string, id int64) (*github.Response, error) {
+	return m.MockDeleteDeploymentBranchPolicy(ctx, owner, repo, env, id)
+}
+
+// GetUser is a fake GetUser SDK method
+func (m *MockEnvironmentService) GetUser(ctx context.Context, user string) (*github.User, *github.Response, error) {
+	return m.MockGetUser(ctx, user)
+}
+
+// GetTeamBySlug is a fake GetTeamBySlug SDK method
+func (m *MockEnvironmentService) GetTeamBySlug(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+	return m.MockGetTeamBySlug(ctx, org, slug)
+}
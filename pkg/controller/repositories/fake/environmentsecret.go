@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+)
+
+// This ensures that the mock implements the EnvironmentService interface
+var _ secretstores.EnvironmentService = (*MockEnvironmentSecretService)(nil)
+
+// MockEnvironmentSecretService is a mock implementation of the secretstores
+// EnvironmentService
+type MockEnvironmentSecretService struct {
+	MockGetEnvPublicKey         func(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error)
+	MockGetEnvSecret            func(ctx context.Context, repoID int64, env, name string) (*github.Secret, *github.Response, error)
+	MockCreateOrUpdateEnvSecret func(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	MockDeleteEnvSecret         func(ctx context.Context, repoID int64, env, name string) (*github.Response, error)
+}
+
+// GetEnvPublicKey is a fake GetEnvPublicKey SDK method
+func (m *MockEnvironmentSecretService) GetEnvPublicKey(ctx context.Context, repoID int64, env string) (*github.PublicKey, *github.Response, error) {
+	return m.MockGetEnvPublicKey(ctx, repoID, env)
+}
+
+// GetEnvSecret is a fake GetEnvSecret SDK method
+func (m *MockEnvironmentSecretService) GetEnvSecret(ctx context.Context, repoID int64, env, name string) (*github.Secret, *github.Response, error) {
+	return m.MockGetEnvSecret(ctx, repoID, env, name)
+}
+
+// CreateOrUpdateEnvSecret is a fake CreateOrUpdateEnvSecret SDK method
+func (m *MockEnvironmentSecretService) CreateOrUpdateEnvSecret(ctx context.Context, repoID int64, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return m.MockCreateOrUpdateEnvSecret(ctx, repoID, env, eSecret)
+}
+
+// DeleteEnvSecret is a fake DeleteEnvSecret SDK method
+func (m *MockEnvironmentSecretService) DeleteEnvSecret(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+	return m.MockDeleteEnvSecret(ctx, repoID, env, name)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/labels"
+)
+
+// This ensures that the mock implements the Service interface
+var _ labels.Service = (*MockLabelService)(nil)
+
+// MockLabelService is a mock implementation of the labels Service
+type MockLabelService struct {
+	MockGetLabel    func(ctx context.Context, owner, repo, name string) (*github.Label, *github.Response, error)
+	MockListLabels  func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error)
+	MockCreateLabel func(ctx context.Context, owner, repo string, label *github.Label) (*github.Label, *github.Response, error)
+	MockEditLabel   func(ctx context.Context, owner, repo, name string, edit *labels.LabelEdit) (*github.Label, *github.Response, error)
+	MockDeleteLabel func(ctx context.Context, owner, repo, name string) (*github.Response, error)
+}
+
+// GetLabel is a fake GetLabel SDK method
+func (m *MockLabelService) GetLabel(ctx context.Context, owner, repo, name string) (*github.Label, *github.Response, error) {
+	return m.MockGetLabel(ctx, owner, repo, name)
+}
+
+// ListLabels is a fake ListLabels SDK method
+func (m *MockLabelService) ListLabels(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return m.MockListLabels(ctx, owner, repo, opts)
+}
+
+// CreateLabel is a fake CreateLabel SDK method
+func (m *MockLabelService) CreateLabel(ctx context.Context, owner, repo string, label *github.Label) (*github.Label, *github.Response, error) {
+	return m.MockCreateLabel(ctx, owner, repo, label)
+}
+
+// EditLabel is a fake EditLabel SDK method
+func (m *MockLabelService) EditLabel(ctx context.Context, owner, repo, name string, edit *labels.LabelEdit) (*github.Label, *github.Response, error) {
+	return m.MockEditLabel(ctx, owner, repo, name, edit)
+}
+
+// DeleteLabel is a fake DeleteLabel SDK method
+func (m *MockLabelService) DeleteLabel(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	return m.MockDeleteLabel(ctx, owner, repo, name)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/repositoryfiles"
+)
+
+// This ensures that the mock implements the Service interface
+var _ repositoryfiles.Service = (*MockRepositoryFileService)(nil)
+
+// MockRepositoryFileService is a mock implementation of the repositoryfiles
+// Service
+type MockRepositoryFileService struct {
+	MockGetContents func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error)
+	MockCreateFile  func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	MockUpdateFile  func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	MockDeleteFile  func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+}
+
+// GetContents is a fake GetContents SDK method
+func (m *MockRepositoryFileService) GetContents(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+	return m.MockGetContents(ctx, owner, repo, path, opts)
+}
+
+// CreateFile is a fake CreateFile SDK method
+func (m *MockRepositoryFileService) CreateFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return m.MockCreateFile(ctx, owner, repo, path, opts)
+}
+
+// UpdateFile is a fake UpdateFile SDK method
+func (m *MockRepositoryFileService) UpdateFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return m.MockUpdateFile(ctx, owner, repo, path, opts)
+}
+
+// DeleteFile is a fake DeleteFile SDK method
+func (m *MockRepositoryFileService) DeleteFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return m.MockDeleteFile(ctx, owner, repo, path, opts)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/rulesets"
+)
+
+// This ensures that the mock implements the Service interface
+var _ rulesets.Service = (*MockRulesetService)(nil)
+
+// MockRulesetService is a mock implementation of the rulesets Service
+type MockRulesetService struct {
+	MockGetRepositoryRuleset    func(ctx context.Context, owner, repo string, id int64) (*rulesets.Ruleset, *github.Response, error)
+	MockCreateRepositoryRuleset func(ctx context.Context, owner, repo string, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error)
+	MockUpdateRepositoryRuleset func(ctx context.Context, owner, repo string, id int64, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error)
+	MockDeleteRepositoryRuleset func(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
+
+	MockGetOrganizationRuleset    func(ctx context.Context, org string, id int64) (*rulesets.Ruleset, *github.Response, error)
+	MockCreateOrganizationRuleset func(ctx context.Context, org string, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error)
+	MockUpdateOrganizationRuleset func(ctx context.Context, org string, id int64, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error)
+	MockDeleteOrganizationRuleset func(ctx context.Context, org string, id int64) (*github.Response, error)
+}
+
+// GetRepositoryRuleset is a fake GetRepositoryRuleset SDK method
+func (m *MockRulesetService) GetRepositoryRuleset(ctx context.Context, owner, repo string, id int64) (*rulesets.Ruleset, *github.Response, error) {
+	return m.MockGetRepositoryRuleset(ctx, owner, repo, id)
+}
+
+// CreateRepositoryRuleset is a fake CreateRepositoryRuleset SDK method
+func (m *MockRulesetService) CreateRepositoryRuleset(ctx context.Context, owner, repo string, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+	return m.MockCreateRepositoryRuleset(ctx, owner, repo, rs)
+}
+
+// UpdateRepositoryRuleset is a fake UpdateRepositoryRuleset SDK method
+func (m *MockRulesetService) UpdateRepositoryRuleset(ctx context.Context, owner, repo string, id int64, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+	return m.MockUpdateRepositoryRuleset(ctx, owner, repo, id, rs)
+}
+
+// DeleteRepositoryRuleset is a fake DeleteRepositoryRuleset SDK method
+func (m *MockRulesetService) DeleteRepositoryRuleset(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+	return m.MockDeleteRepositoryRuleset(ctx, owner, repo, id)
+}
+
+// GetOrganizationRuleset is a fake GetOrganizationRuleset SDK method
+func (m *MockRulesetService) GetOrganizationRuleset(ctx context.Context, org string, id int64) (*rulesets.Ruleset, *github.Response, error) {
+	return m.MockGetOrganizationRuleset(ctx, org, id)
+}
+
+// CreateOrganizationRuleset is a fake CreateOrganizationRuleset SDK method
+func (m *MockRulesetService) CreateOrganizationRuleset(ctx context.Context, org string, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+	return m.MockCreateOrganizationRuleset(ctx, org, rs)
+}
+
+// UpdateOrganizationRuleset is a fake UpdateOrganizationRuleset SDK method
+func (m *MockRulesetService) UpdateOrganizationRuleset(ctx context.Context, org string, id int64, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+	return m.MockUpdateOrganizationRuleset(ctx, org, id, rs)
+}
+
+// DeleteOrganizationRuleset is a fake DeleteOrganizationRuleset SDK method
+func (m *MockRulesetService) DeleteOrganizationRuleset(ctx context.Context, org string, id int64) (*github.Response, error) {
+	return m.MockDeleteOrganizationRuleset(ctx, org, id)
+}
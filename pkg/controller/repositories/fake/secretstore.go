@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+)
+
+// This ensures that the mock implements the Service interface
+var _ secretstores.Service = (*MockSecretStoreService)(nil)
+
+// MockSecretStoreService is a mock implementation of the secretstores
+// Service
+type MockSecretStoreService struct {
+	MockGetRepoPublicKey         func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error)
+	MockGetRepoSecret            func(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error)
+	MockCreateOrUpdateRepoSecret func(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	MockDeleteRepoSecret         func(ctx context.Context, owner, repo, name string) (*github.Response, error)
+
+	MockGetOrgPublicKey               func(ctx context.Context, org string) (*github.PublicKey, *github.Response, error)
+	MockGetOrgSecret                  func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
+	MockCreateOrUpdateOrgSecret       func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	MockDeleteOrgSecret               func(ctx context.Context, org, name string) (*github.Response, error)
+	MockListSelectedReposForOrgSecret func(ctx context.Context, org, name string) ([]*github.Repository, error)
+}
+
+// GetRepoPublicKey is a fake GetRepoPublicKey SDK method
+func (m *MockSecretStoreService) GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+	return m.MockGetRepoPublicKey(ctx, owner, repo)
+}
+
+// GetRepoSecret is a fake GetRepoSecret SDK method
+func (m *MockSecretStoreService) GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+	return m.MockGetRepoSecret(ctx, owner, repo, name)
+}
+
+// CreateOrUpdateRepoSecret is a fake CreateOrUpdateRepoSecret SDK method
+func (m *MockSecretStoreService) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return m.MockCreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+
+// DeleteRepoSecret is a fake DeleteRepoSecret SDK method
+func (m *MockSecretStoreService) DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	return m.MockDeleteRepoSecret(ctx, owner, repo, name)
+}
+
+// GetOrgPublicKey is a fake GetOrgPublicKey SDK method
+func (m *MockSecretStoreService) GetOrgPublicKey(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+	return m.MockGetOrgPublicKey(ctx, org)
+}
+
+// GetOrgSecret is a fake GetOrgSecret SDK method
+func (m *MockSecretStoreService) GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+	return m.MockGetOrgSecret(ctx, org, name)
+}
+
+// CreateOrUpdateOrgSecret is a fake CreateOrUpdateOrgSecret SDK method
+func (m *MockSecretStoreService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return m.MockCreateOrUpdateOrgSecret(ctx, org, eSecret)
+}
+
+// DeleteOrgSecret is a fake DeleteOrgSecret SDK method
+func (m *MockSecretStoreService) DeleteOrgSecret(ctx context.Context, org, name string) (*github.Response, error) {
+	return m.MockDeleteOrgSecret(ctx, org, name)
+}
+
+// ListSelectedReposForOrgSecret is a fake ListSelectedReposForOrgSecret SDK
+// method
+func (m *MockSecretStoreService) ListSelectedReposForOrgSecret(ctx context.Context, org, name string) ([]*github.Repository, error) {
+	return m.MockListSelectedReposForOrgSecret(ctx, org, name)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/variables"
+)
+
+// This ensures that the mock implements the Service interface
+var _ variables.Service = (*MockVariableService)(nil)
+
+// MockVariableService is a mock implementation of the variables Service
+type MockVariableService struct {
+	MockGetRepoVariable    func(ctx context.Context, owner, repo, name string) (*variables.Variable, *github.Response, error)
+	MockCreateRepoVariable func(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error)
+	MockUpdateRepoVariable func(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error)
+	MockDeleteRepoVariable func(ctx context.Context, owner, repo, name string) (*github.Response, error)
+
+	MockGetEnvVariable    func(ctx context.Context, repoID int64, env, name string) (*variables.Variable, *github.Response, error)
+	MockCreateEnvVariable func(ctx context.Context, repoID int64, env string, v *variables.Variable) (*github.Response, error)
+	MockUpdateEnvVariable func(ctx context.Context, repoID int64, env string, v *variables.Variable) (*github.Response, error)
+	MockDeleteEnvVariable func(ctx context.Context, repoID int64, env, name string) (*github.Response, error)
+
+	MockGetOrgVariable                  func(ctx context.Context, org, name string) (*variables.Variable, *github.Response, error)
+	MockCreateOrgVariable               func(ctx context.Context, org string, v *variables.Variable) (*github.Response, error)
+	MockUpdateOrgVariable               func(ctx context.Context, org string, v *variables.Variable) (*github.Response, error)
+	MockDeleteOrgVariable               func(ctx context.Context, org, name string) (*github.Response, error)
+	MockListSelectedReposForOrgVariable func(ctx context.Context, org, name string) ([]*github.Repository, error)
+}
+
+// GetRepoVariable is a fake GetRepoVariable SDK method
+func (m *MockVariableService) GetRepoVariable(ctx context.Context, owner, repo, name string) (*variables.Variable, *github.Response, error) {
+	return m.MockGetRepoVariable(ctx, owner, repo, name)
+}
+
+// CreateRepoVariable is a fake CreateRepoVariable SDK method
+func (m *MockVariableService) CreateRepoVariable(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error) {
+	return m.MockCreateRepoVariable(ctx, owner, repo, v)
+}
+
+// UpdateRepoVariable is a fake UpdateRepoVariable SDK method
+func (m *MockVariableService) UpdateRepoVariable(ctx context.Context, owner, repo string, v *variables.Variable) (*github.Response, error) {
+	return m.MockUpdateRepoVariable(ctx, owner, repo, v)
+}
+
+// DeleteRepoVariable is a fake DeleteRepoVariable SDK method
+func (m *MockVariableService) DeleteRepoVariable(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+	return m.MockDeleteRepoVariable(ctx, owner, repo, name)
+}
+
+// GetEnvVariable is a fake GetEnvVariable SDK method
+func (m *MockVariableService) GetEnvVariable(ctx context.Context, repoID int64, env, name string) (*variables.Variable, *github.Response, error) {
+	return m.MockGetEnvVariable(ctx, repoID, env, name)
+}
+
+// CreateEnvVariable is a fake CreateEnvVariable SDK method
+func (m *MockVariableService) CreateEnvVariable(ctx context.Context, repoID int64, env string, v *variables.Variable) (*github.Response, error) {
+	return m.MockCreateEnvVariable(ctx, repoID, env, v)
+}
+
+// UpdateEnvVariable is a fake UpdateEnvVariable SDK method
+func (m *MockVariableService) UpdateEnvVariable(ctx context.Context, repoID int64, env string, v *variables.Variable) (*github.Response, error) {
+	return m.MockUpdateEnvVariable(ctx, repoID, env, v)
+}
+
+// DeleteEnvVariable is a fake DeleteEnvVariable SDK method
+func (m *MockVariableService) DeleteEnvVariable(ctx context.Context, repoID int64, env, name string) (*github.Response, error) {
+	return m.MockDeleteEnvVariable(ctx, repoID, env, name)
+}
+
+// GetOrgVariable is a fake GetOrgVariable SDK method
+func (m *MockVariableService) GetOrgVariable(ctx context.Context, org, name string) (*variables.Variable, *github.Response, error) {
+	return m.MockGetOrgVariable(ctx, org, name)
+}
+
+// CreateOrgVariable is a fake CreateOrgVariable SDK method
+func (m *MockVariableService) CreateOrgVariable(ctx context.Context, org string, v *variables.Variable) (*github.Response, error) {
+	return m.MockCreateOrgVariable(ctx, org, v)
+}
+
+// UpdateOrgVariable is a fake UpdateOrgVariable SDK method
+func (m *MockVariableService) UpdateOrgVariable(ctx context.Context, org string, v *variables.Variable) (*github.Response, error) {
+	return m.MockUpdateOrgVariable(ctx, org, v)
+}
+
+// DeleteOrgVariable is a fake DeleteOrgVariable SDK method
+func (m *MockVariableService) DeleteOrgVariable(ctx context.Context, org, name string) (*github.Response, error) {
+	return m.MockDeleteOrgVariable(ctx, org, name)
+}
+
+// ListSelectedReposForOrgVariable is a fake ListSelectedReposForOrgVariable
+// SDK method
+func (m *MockVariableService) ListSelectedReposForOrgVariable(ctx context.Context, org, name string) ([]*github.Repository, error) {
+	return m.MockListSelectedReposForOrgVariable(ctx, org, name)
+}
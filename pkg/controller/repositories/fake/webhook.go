@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+
+	"github.com/crossplane-contrib/provider-github/pkg/clients/webhooks"
+)
+
+// This ensures that the mock implements the Service interface
+var _ webhooks.Service = (*MockWebhookService)(nil)
+
+// MockWebhookService is a mock implementation of the webhooks Service
+type MockWebhookService struct {
+	MockGetHook    func(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error)
+	MockCreateHook func(ctx context.Context, owner, repo string, hook *github.Hook) (*webhooks.Hook, *github.Response, error)
+	MockEditHook   func(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*webhooks.Hook, *github.Response, error)
+	MockDeleteHook func(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
+
+	MockGetOrganizationHook            func(ctx context.Context, org string, id int64) (*webhooks.Hook, *github.Response, error)
+	MockCreateOrganizationHook         func(ctx context.Context, org string, hook *github.Hook) (*webhooks.Hook, *github.Response, error)
+	MockEditOrganizationHook           func(ctx context.Context, org string, id int64, hook *github.Hook) (*webhooks.Hook, *github.Response, error)
+	MockDeleteOrganizationHook         func(ctx context.Context, org string, id int64) (*github.Response, error)
+	MockListOrganizationHookDeliveries func(ctx context.Context, org string, id int64, opts *github.ListOptions) ([]*webhooks.HookDelivery, *github.Response, error)
+}
+
+// GetHook is a fake GetHook SDK method
+func (m *MockWebhookService) GetHook(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error) {
+	return m.MockGetHook(ctx, owner, repo, id)
+}
+
+// CreateHook is a fake CreateHook SDK method
+func (m *MockWebhookService) CreateHook(ctx context.Context, owner, repo string, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+	return m.MockCreateHook(ctx, owner, repo, hook)
+}
+
+// EditHook is a fake EditHook SDK method
+func (m *MockWebhookService) EditHook(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+	return m.MockEditHook(ctx, owner, repo, id, hook)
+}
+
+// DeleteHook is a fake DeleteHook SDK method
+func (m *MockWebhookService) DeleteHook(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+	return m.MockDeleteHook(ctx, owner, repo, id)
+}
+
+// GetOrganizationHook is a fake GetOrganizationHook SDK method
+func (m *MockWebhookService) GetOrganizationHook(ctx context.Context, org string, id int64) (*webhooks.Hook, *github.Response, error) {
+	return m.MockGetOrganizationHook(ctx, org, id)
+}
+
+// CreateOrganizationHook is a fake CreateOrganizationHook SDK method
+func (m *MockWebhookService) CreateOrganizationHook(ctx context.Context, org string, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+	return m.MockCreateOrganizationHook(ctx, org, hook)
+}
+
+// EditOrganizationHook is a fake EditOrganizationHook SDK method
+func (m *MockWebhookService) EditOrganizationHook(ctx context.Context, org string, id int64, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+	return m.MockEditOrganizationHook(ctx, org, id, hook)
+}
+
+// DeleteOrganizationHook is a fake DeleteOrganizationHook SDK method
+func (m *MockWebhookService) DeleteOrganizationHook(ctx context.Context, org string, id int64) (*github.Response, error) {
+	return m.MockDeleteOrganizationHook(ctx, org, id)
+}
+
+// ListOrganizationHookDeliveries is a fake ListOrganizationHookDeliveries SDK
+// method
+func (m *MockWebhookService) ListOrganizationHookDeliveries(ctx context.Context, org string, id int64, opts *github.ListOptions) ([]*webhooks.HookDelivery, *github.Response, error) {
+	return m.MockListOrganizationHookDeliveries(ctx, org, id, opts)
+}
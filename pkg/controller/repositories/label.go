@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/labels"
+)
+
+const (
+	errUnexpectedLabel = "The managed resource is not a Label resource"
+	errGetLabel        = "cannot get Label"
+	errCreateLabel     = "cannot create Label"
+	errUpdateLabel     = "cannot update Label"
+	errDeleteLabel     = "cannot delete Label"
+	errKubeUpdateLabel = "cannot update Label custom resource"
+)
+
+// SetupLabel adds a controller that reconciles Labels.
+func SetupLabel(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.LabelGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Label{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LabelGroupVersionKind),
+			managed.WithExternalConnecter(&labelConnector{client: mgr.GetClient(), newClientFn: labels.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type labelConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*labels.Service, error)
+}
+
+func (c *labelConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Label)
+	if !ok {
+		return nil, errors.New(errUnexpectedLabel)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &labelExternal{*gh, c.client}, nil
+}
+
+type labelExternal struct {
+	gh     labels.Service
+	client client.Client
+}
+
+// labelName returns the name the label currently has, which is its external
+// name once it is created.
+func labelName(cr *v1alpha1.Label) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+	return cr.Spec.ForProvider.Name
+}
+
+// getLabel gets the label by its current name. If it is not found, a second
+// attempt is made with the desired name, in case the label was renamed but
+// its new name could not be recorded.
+func (e *labelExternal) getLabel(ctx context.Context, cr *v1alpha1.Label) (*github.Label, error) {
+	p := cr.Spec.ForProvider
+	l, _, err := e.gh.GetLabel(ctx, p.Owner, p.Repository, labelName(cr))
+	if err == nil || !ghclient.IsNotFound(err) || labelName(cr) == p.Name {
+		return l, err
+	}
+	l, _, err = e.gh.GetLabel(ctx, p.Owner, p.Repository, p.Name)
+	return l, err
+}
+
+func (e *labelExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Label)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedLabel)
+	}
+
+	l, err := e.getLabel(ctx, cr)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetLabel)
+	}
+
+	lateInit := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	labels.LateInitialize(&cr.Spec.ForProvider, l)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) || meta.GetExternalName(cr) != l.GetName() {
+		meta.SetExternalName(cr, l.GetName())
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateLabel)
+		}
+		lateInit = true
+	}
+
+	cr.Status.AtProvider = labels.GenerateObservation(l)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate:        labels.IsUpToDate(cr.Spec.ForProvider, l),
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+	}, nil
+}
+
+func (e *labelExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Label)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedLabel)
+	}
+
+	p := cr.Spec.ForProvider
+	l, _, err := e.gh.CreateLabel(ctx, p.Owner, p.Repository, labels.GenerateLabel(p))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateLabel)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	meta.SetExternalName(cr, l.GetName())
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *labelExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Label)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedLabel)
+	}
+
+	p := cr.Spec.ForProvider
+	l, _, err := e.gh.EditLabel(ctx, p.Owner, p.Repository, labelName(cr), labels.GenerateLabelEdit(p))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLabel)
+	}
+
+	// The label is looked up by its external name, which has to follow the
+	// label when it is renamed.
+	if meta.GetExternalName(cr) != l.GetName() {
+		meta.SetExternalName(cr, l.GetName())
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateLabel)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *labelExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Label)
+	if !ok {
+		return errors.New(errUnexpectedLabel)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.gh.DeleteLabel(ctx, p.Owner, p.Repository, labelName(cr))
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteLabel)
+}
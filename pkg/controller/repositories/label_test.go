@@ -0,0 +1,412 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/labels"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeLabelName        = "needs: triage"
+	fakeLabelColor       = "fbca04"
+	fakeLabelDescription = "Issues that have not been triaged yet"
+)
+
+func newLabel(externalName, name string) *v1alpha1.Label {
+	r := &v1alpha1.Label{}
+	meta.SetExternalName(r, externalName)
+	r.Spec.ForProvider = v1alpha1.LabelParameters{
+		Owner:       fakeOwner,
+		Repository:  fakeRepository,
+		Name:        name,
+		Color:       fakeLabelColor,
+		Description: &fakeLabelDescription,
+	}
+	return r
+}
+
+func observedLabel(name string) *github.Label {
+	return &github.Label{
+		ID:          github.Int64(208045946),
+		Name:        github.String(name),
+		Color:       github.String(fakeLabelColor),
+		Description: github.String(fakeLabelDescription),
+	}
+}
+
+// labelsByName returns a GetLabel fake that only finds the supplied labels.
+func labelsByName(names ...string) func(ctx context.Context, owner, repo, name string) (*github.Label, *github.Response, error) {
+	return func(ctx context.Context, owner, repo, name string) (*github.Label, *github.Response, error) {
+		for _, n := range names {
+			if n == name {
+				return observedLabel(name), nil, nil
+			}
+		}
+		return nil, nil, errNotFound
+	}
+}
+
+type labelArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github labels.Service
+}
+
+func TestLabelObserve(t *testing.T) {
+	type want struct {
+		eo           managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   labelArgs
+		want   want
+	}{
+		"ResourceIsNotLabel": {
+			reason: "Must return an error if the resource is not a Label",
+			args: labelArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedLabel),
+			},
+		},
+		"CannotGetLabel": {
+			reason: "Must return an error if GET label fails and the error is not 404",
+			args: labelArgs{
+				mg: newLabel(fakeLabelName, fakeLabelName),
+				github: &fake.MockLabelService{
+					MockGetLabel: func(ctx context.Context, owner, repo, name string) (*github.Label, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetLabel),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the label does not exist",
+			args: labelArgs{
+				mg: newLabel("", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockGetLabel: labelsByName(),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"Import": {
+			reason: "Must record the name of an existing label as external name",
+			args: labelArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg:   newLabel("", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockGetLabel: labelsByName(fakeLabelName),
+				},
+			},
+			want: want{
+				eo:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				externalName: fakeLabelName,
+			},
+		},
+		"Rename": {
+			reason: "Must not be up to date if the label has to be renamed",
+			args: labelArgs{
+				mg: newLabel("triage", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockGetLabel: labelsByName("triage"),
+				},
+			},
+			want: want{
+				eo:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: "triage",
+			},
+		},
+		"RenamedExternally": {
+			reason: "Must find a label under its new name if its external name was not updated",
+			args: labelArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg:   newLabel("triage", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockGetLabel: labelsByName(fakeLabelName),
+				},
+			},
+			want: want{
+				eo:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				externalName: fakeLabelName,
+			},
+		},
+		"CannotUpdateManaged": {
+			reason: "Must return an error if the external name cannot be recorded",
+			args: labelArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   newLabel("", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockGetLabel: labelsByName(fakeLabelName),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateLabel),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := labelExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.externalName != "" {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	type want struct {
+		eo           managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   labelArgs
+		want   want
+	}{
+		"ResourceIsNotLabel": {
+			reason: "Must return an error if the resource is not a Label",
+			args: labelArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedLabel),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the label cannot be created",
+			args: labelArgs{
+				mg: newLabel("", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockCreateLabel: func(ctx context.Context, owner, repo string, label *github.Label) (*github.Label, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateLabel),
+			},
+		},
+		"Success": {
+			reason: "Must create the label and set its name as external name",
+			args: labelArgs{
+				mg: newLabel("", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockCreateLabel: func(ctx context.Context, owner, repo string, label *github.Label) (*github.Label, *github.Response, error) {
+						if label.GetName() != fakeLabelName || label.GetColor() != fakeLabelColor {
+							return nil, nil, errBoom
+						}
+						return observedLabel(label.GetName()), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo:           managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: fakeLabelName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := labelExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.externalName != "" {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	renameTo := func(ctx context.Context, owner, repo, name string, edit *labels.LabelEdit) (*github.Label, *github.Response, error) {
+		if name != "triage" {
+			return nil, nil, errNotFound
+		}
+		return observedLabel(edit.NewName), nil, nil
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   labelArgs
+		want   want
+	}{
+		"ResourceIsNotLabel": {
+			reason: "Must return an error if the resource is not a Label",
+			args: labelArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedLabel),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the label cannot be edited",
+			args: labelArgs{
+				mg: newLabel("triage", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockEditLabel: func(ctx context.Context, owner, repo, name string, edit *labels.LabelEdit) (*github.Label, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateLabel),
+			},
+		},
+		"CannotUpdateManaged": {
+			reason: "Must return an error if the new name of the label cannot be recorded",
+			args: labelArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   newLabel("triage", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockEditLabel: renameTo,
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateLabel),
+			},
+		},
+		"Renamed": {
+			reason: "Must rename the label by its external name and record its new name",
+			args: labelArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg:   newLabel("triage", fakeLabelName),
+				github: &fake.MockLabelService{
+					MockEditLabel: renameTo,
+				},
+			},
+			want: want{
+				externalName: fakeLabelName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := labelExternal{gh: tc.args.github, client: tc.args.kube}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.externalName != "" {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+					t.Errorf("\n%s\nUpdate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   labelArgs
+		want   error
+	}{
+		"ResourceIsNotLabel": {
+			reason: "Must return an error if the resource is not a Label",
+			args: labelArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedLabel),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the label cannot be deleted",
+			args: labelArgs{
+				mg: newLabel(fakeLabelName, fakeLabelName),
+				github: &fake.MockLabelService{
+					MockDeleteLabel: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteLabel),
+		},
+		"NotFound": {
+			reason: "Must not return an error if the label is already gone",
+			args: labelArgs{
+				mg: newLabel(fakeLabelName, fakeLabelName),
+				github: &fake.MockLabelService{
+					MockDeleteLabel: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := labelExternal{gh: tc.args.github, client: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
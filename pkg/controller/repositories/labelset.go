@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/labels"
+)
+
+const (
+	errUnexpectedLabelSet = "The managed resource is not a LabelSet resource"
+	errGetLabelSet        = "cannot get LabelSet"
+	errCreateLabelSet     = "cannot create LabelSet"
+	errUpdateLabelSet     = "cannot update LabelSet"
+	errDeleteLabelSet     = "cannot delete LabelSet"
+	errListLabels         = "cannot list labels of LabelSet Repository"
+	errCreateSetLabel     = "cannot create label of LabelSet"
+	errEditSetLabel       = "cannot edit label of LabelSet"
+	errDeleteSetLabel     = "cannot delete label of LabelSet"
+)
+
+// SetupLabelSet adds a controller that reconciles LabelSets.
+func SetupLabelSet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.LabelSetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.LabelSet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LabelSetGroupVersionKind),
+			managed.WithExternalConnecter(&labelSetConnector{client: mgr.GetClient(), newClientFn: labels.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type labelSetConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*labels.Service, error)
+}
+
+func (c *labelSetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return nil, errors.New(errUnexpectedLabelSet)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &labelSetExternal{*gh}, nil
+}
+
+type labelSetExternal struct {
+	gh labels.Service
+}
+
+func (e *labelSetExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedLabelSet)
+	}
+
+	// The labels of a LabelSet that is deleted are kept unless they are
+	// deleted with it, so there is nothing left to delete.
+	p := cr.Spec.ForProvider
+	if meta.WasDeleted(cr) && !ghclient.BoolValue(p.DeleteLabels) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	l, err := labels.ListAllLabels(ctx, e.gh, p.Owner, p.Repository)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetLabelSet)
+	}
+
+	// A LabelSet exists as long as the Repository has one of its labels, so
+	// that it is gone once Delete removed them.
+	cr.Status.AtProvider = labels.GenerateLabelSetObservation(p, l)
+	if cr.Status.AtProvider.ManagedLabels == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate: labels.DiffLabelSet(p, l).IsEmpty(),
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *labelSetExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedLabelSet)
+	}
+
+	if err := e.sync(ctx, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateLabelSet)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *labelSetExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedLabelSet)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.sync(ctx, cr.Spec.ForProvider), errUpdateLabelSet)
+}
+
+func (e *labelSetExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LabelSet)
+	if !ok {
+		return errors.New(errUnexpectedLabelSet)
+	}
+
+	// Only the labels of the set are deleted, whatever DeleteUnmanaged is.
+	// Observe reports the labels gone if they are kept.
+	p := cr.Spec.ForProvider
+	for _, l := range p.Labels {
+		_, err := e.gh.DeleteLabel(ctx, p.Owner, p.Repository, l.Name)
+		if err := resource.Ignore(ghclient.IsNotFound, err); err != nil {
+			return errors.Wrap(err, errDeleteLabelSet)
+		}
+	}
+	return nil
+}
+
+// sync creates, edits and deletes the labels of the Repository until they
+// match the LabelSet.
+func (e *labelSetExternal) sync(ctx context.Context, p v1alpha1.LabelSetParameters) error {
+	l, err := labels.ListAllLabels(ctx, e.gh, p.Owner, p.Repository)
+	if err != nil {
+		return errors.Wrap(err, errListLabels)
+	}
+
+	d := labels.DiffLabelSet(p, l)
+	for _, c := range d.Create {
+		if _, _, err := e.gh.CreateLabel(ctx, p.Owner, p.Repository, c); err != nil {
+			return errors.Wrap(err, errCreateSetLabel)
+		}
+	}
+	for _, u := range d.Update {
+		if _, _, err := e.gh.EditLabel(ctx, p.Owner, p.Repository, u.Name, u.Edit); err != nil {
+			return errors.Wrap(err, errEditSetLabel)
+		}
+	}
+	for _, name := range d.Delete {
+		_, err := e.gh.DeleteLabel(ctx, p.Owner, p.Repository, name)
+		if err := resource.Ignore(ghclient.IsNotFound, err); err != nil {
+			return errors.Wrap(err, errDeleteSetLabel)
+		}
+	}
+	return nil
+}
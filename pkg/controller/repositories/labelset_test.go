@@ -0,0 +1,322 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/labels"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+func newLabelSet(deleteUnmanaged bool) *v1alpha1.LabelSet {
+	r := &v1alpha1.LabelSet{}
+	r.Spec.ForProvider = v1alpha1.LabelSetParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		Labels: []v1alpha1.LabelSetLabel{
+			{Name: "bug", Color: "d73a4a"},
+			{Name: fakeLabelName, Color: fakeLabelColor, Description: &fakeLabelDescription},
+		},
+		DeleteUnmanaged: &deleteUnmanaged,
+	}
+	return r
+}
+
+func deletedLabelSet(deleteLabels bool) *v1alpha1.LabelSet {
+	r := newLabelSet(false)
+	r.Spec.ForProvider.DeleteLabels = &deleteLabels
+	r.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	return r
+}
+
+// repositoryLabels returns a ListLabels fake that lists the supplied labels
+// one per page.
+func repositoryLabels(l ...*github.Label) func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+		if len(l) == 0 {
+			return nil, &github.Response{}, nil
+		}
+		page := opts.Page
+		if page == 0 {
+			page = 1
+		}
+		res := &github.Response{}
+		if page < len(l) {
+			res.NextPage = page + 1
+		}
+		return l[page-1 : page], res, nil
+	}
+}
+
+func bugLabel(color string) *github.Label {
+	return &github.Label{Name: github.String("bug"), Color: github.String(color)}
+}
+
+type labelSetArgs struct {
+	mg     resource.Managed
+	github labels.Service
+}
+
+func TestLabelSetObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		o   v1alpha1.LabelSetObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   labelSetArgs
+		want   want
+	}{
+		"ResourceIsNotLabelSet": {
+			reason: "Must return an error if the resource is not a LabelSet",
+			args: labelSetArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedLabelSet),
+			},
+		},
+		"CannotListLabels": {
+			reason: "Must return an error if the labels cannot be listed",
+			args: labelSetArgs{
+				mg: newLabelSet(false),
+				github: &fake.MockLabelService{
+					MockListLabels: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetLabelSet),
+			},
+		},
+		"NoLabelOfSet": {
+			reason: "Must not exist if the Repository has none of the labels of the set",
+			args: labelSetArgs{
+				mg: newLabelSet(false),
+				github: &fake.MockLabelService{
+					MockListLabels: repositoryLabels(&github.Label{Name: github.String("wontfix")}),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+				o:  v1alpha1.LabelSetObservation{UnmanagedLabels: []string{"wontfix"}},
+			},
+		},
+		"UpToDate": {
+			reason: "Must be up to date if the Repository has the labels of the set and unmanaged labels are kept",
+			args: labelSetArgs{
+				mg: newLabelSet(false),
+				github: &fake.MockLabelService{
+					MockListLabels: repositoryLabels(bugLabel("D73A4A"), observedLabel(fakeLabelName), &github.Label{Name: github.String("wontfix")}),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				o:  v1alpha1.LabelSetObservation{ManagedLabels: 2, UnmanagedLabels: []string{"wontfix"}},
+			},
+		},
+		"UnmanagedLabel": {
+			reason: "Must not be up to date if unmanaged labels are deleted and the Repository has one",
+			args: labelSetArgs{
+				mg: newLabelSet(true),
+				github: &fake.MockLabelService{
+					MockListLabels: repositoryLabels(bugLabel("d73a4a"), observedLabel(fakeLabelName), &github.Label{Name: github.String("wontfix")}),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				o:  v1alpha1.LabelSetObservation{ManagedLabels: 2, UnmanagedLabels: []string{"wontfix"}},
+			},
+		},
+		"MissingLabel": {
+			reason: "Must not be up to date if a label of the set is missing",
+			args: labelSetArgs{
+				mg: newLabelSet(false),
+				github: &fake.MockLabelService{
+					MockListLabels: repositoryLabels(bugLabel("d73a4a")),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				o:  v1alpha1.LabelSetObservation{ManagedLabels: 1},
+			},
+		},
+		"DeletedLabelsKept": {
+			reason: "Must not exist once it is deleted if its labels are kept",
+			args: labelSetArgs{
+				mg: deletedLabelSet(false),
+				github: &fake.MockLabelService{
+					MockListLabels: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"DeletedLabelsDeleted": {
+			reason: "Must exist once it is deleted as long as the Repository has one of its labels if they are deleted",
+			args: labelSetArgs{
+				mg: deletedLabelSet(true),
+				github: &fake.MockLabelService{
+					MockListLabels: repositoryLabels(bugLabel("d73a4a")),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				o:  v1alpha1.LabelSetObservation{ManagedLabels: 1},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := labelSetExternal{gh: tc.args.github}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.LabelSet); ok && err == nil {
+				if diff := cmp.Diff(tc.want.o, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelSetUpdate(t *testing.T) {
+	type want struct {
+		created []string
+		edited  []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason          string
+		deleteUnmanaged bool
+		editErr         error
+		want            want
+	}{
+		"EditFailed": {
+			reason:  "Must return an error if a label cannot be edited",
+			editErr: errBoom,
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errEditSetLabel), errUpdateLabelSet),
+			},
+		},
+		"KeepUnmanaged": {
+			reason: "Must create missing labels, edit differing ones and keep unmanaged labels",
+			want: want{
+				created: []string{fakeLabelName},
+				edited:  []string{"Bug"},
+			},
+		},
+		"DeleteUnmanaged": {
+			reason:          "Must delete the labels that are not in the set if DeleteUnmanaged is true",
+			deleteUnmanaged: true,
+			want: want{
+				created: []string{fakeLabelName},
+				edited:  []string{"Bug"},
+				deleted: []string{"wontfix"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created, edited, deleted []string
+			gh := &fake.MockLabelService{
+				MockListLabels: repositoryLabels(
+					&github.Label{Name: github.String("Bug"), Color: github.String("d73a4a")},
+					&github.Label{Name: github.String("wontfix")},
+				),
+				MockCreateLabel: func(ctx context.Context, owner, repo string, label *github.Label) (*github.Label, *github.Response, error) {
+					created = append(created, label.GetName())
+					return label, nil, nil
+				},
+				MockEditLabel: func(ctx context.Context, owner, repo, name string, edit *labels.LabelEdit) (*github.Label, *github.Response, error) {
+					edited = append(edited, name)
+					return nil, nil, tc.editErr
+				},
+				MockDeleteLabel: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+					deleted = append(deleted, name)
+					return nil, nil
+				},
+			}
+			e := labelSetExternal{gh: gh}
+			_, err := e.Update(context.Background(), newLabelSet(tc.deleteUnmanaged))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want created, +got created:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.edited, edited); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want edited, +got edited:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want deleted, +got deleted:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLabelSetDelete(t *testing.T) {
+	var deleted []string
+	gh := &fake.MockLabelService{
+		MockDeleteLabel: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+			deleted = append(deleted, name)
+			if name == "bug" {
+				return nil, errNotFound
+			}
+			return nil, nil
+		},
+	}
+
+	e := labelSetExternal{gh: gh}
+	if err := e.Delete(context.Background(), newLabelSet(true)); err != nil {
+		t.Errorf("Delete(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{"bug", fakeLabelName}, deleted); diff != "" {
+		t.Errorf("Delete(...): must only delete the labels of the set: -want, +got:\n%s", diff)
+	}
+}
@@ -42,6 +42,7 @@ import (
 
 const (
 	errUnexpectedObject     = "The managed resource is not a Repository resource"
+	errNewClient            = "cannot create new GitHub client"
 	errGetRepository        = "Cannot get GitHub repository"
 	errCheckUpToDate        = "unable to determine if external resource is up to date"
 	errCreateRepository     = "cannot create Repository"
@@ -81,7 +82,7 @@ func SetupRepository(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 
 type connector struct {
 	client      client.Client
-	newClientFn func(string) *repositories.Service
+	newClientFn func(ghclient.Config) (*repositories.Service, error)
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -93,7 +94,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{*c.newClientFn(string(cfg)), c.client}, nil
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &external{*gh, c.client}, nil
 }
 
 type external struct {
@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/repositoryfiles"
+)
+
+const (
+	errUnexpectedRepositoryFile  = "The managed resource is not a RepositoryFile resource"
+	errGetRepositoryFile         = "cannot get RepositoryFile"
+	errRepositoryFileIsDirectory = "the path of the RepositoryFile is a directory"
+	errGetRepositoryFileContent  = "cannot get content of RepositoryFile"
+	errCreateRepositoryFile      = "cannot create RepositoryFile"
+	errUpdateRepositoryFile      = "cannot update RepositoryFile"
+	errDeleteRepositoryFile      = "cannot delete RepositoryFile"
+	errKubeUpdateRepositoryFile  = "cannot update RepositoryFile custom resource"
+)
+
+// The commits of a RepositoryFile without a commit message are named after
+// what they do to the file.
+const (
+	repositoryFileActionCreate = "Create"
+	repositoryFileActionUpdate = "Update"
+	repositoryFileActionDelete = "Delete"
+)
+
+// SetupRepositoryFile adds a controller that reconciles RepositoryFiles.
+func SetupRepositoryFile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.RepositoryFileGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.RepositoryFile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryFileGroupVersionKind),
+			managed.WithExternalConnecter(&repositoryFileConnector{client: mgr.GetClient(), newClientFn: repositoryfiles.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type repositoryFileConnector struct {
+	client      client.Client
+	newClientFn func(ghclient.Config) (*repositoryfiles.Service, error)
+}
+
+func (c *repositoryFileConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return nil, errors.New(errUnexpectedRepositoryFile)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, cr)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &repositoryFileExternal{*gh, c.client}, nil
+}
+
+type repositoryFileExternal struct {
+	gh     repositoryfiles.Service
+	client client.Client
+}
+
+func (e *repositoryFileExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedRepositoryFile)
+	}
+
+	p := cr.Spec.ForProvider
+	f, _, _, err := e.gh.GetContents(ctx, p.Owner, p.Repository, p.Path, repositoryfiles.GetOptions(p))
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			cr.Status.AtProvider = v1alpha1.RepositoryFileObservation{}
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRepositoryFile)
+	}
+	if f == nil {
+		return managed.ExternalObservation{}, errors.New(errRepositoryFileIsDirectory)
+	}
+
+	content, err := repositoryfiles.GetContent(ctx, e.client, p)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRepositoryFileContent)
+	}
+
+	// A file that already has the content, like one committed before the
+	// RepositoryFile was created, is adopted rather than reported modified.
+	if _, ok := cr.GetAnnotations()[repositoryfiles.AnnotationKeyBlobSHA]; !ok && repositoryfiles.BlobSHA(content) == f.GetSHA() {
+		repositoryfiles.SetBlobSHA(cr, f.GetSHA())
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateRepositoryFile)
+		}
+	}
+
+	modified := repositoryfiles.IsModified(cr, f)
+	cr.Status.AtProvider = repositoryfiles.GenerateObservation(f, modified)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceUpToDate: repositoryfiles.IsUpToDate(p, content, f, modified),
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *repositoryFileExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedRepositoryFile)
+	}
+
+	if err := e.commit(ctx, cr, repositoryFileActionCreate, e.gh.CreateFile); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRepositoryFile)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *repositoryFileExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedRepositoryFile)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.commit(ctx, cr, repositoryFileActionUpdate, e.gh.UpdateFile), errUpdateRepositoryFile)
+}
+
+func (e *repositoryFileExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return errors.New(errUnexpectedRepositoryFile)
+	}
+
+	// A file changed after it was last committed by Crossplane is kept, like
+	// its changes are, unless they are overwritten.
+	p := cr.Spec.ForProvider
+	if cr.Status.AtProvider.Modified && !repositoryfiles.OverwritesChanges(p) {
+		return nil
+	}
+
+	opts := repositoryfiles.GenerateFileOptions(p, repositoryFileActionDelete)
+	opts.SHA = ghclient.StringPtr(cr.Status.AtProvider.SHA)
+	_, _, err := e.gh.DeleteFile(ctx, p.Owner, p.Repository, p.Path, opts)
+	return errors.Wrap(resource.Ignore(ghclient.IsNotFound, err), errDeleteRepositoryFile)
+}
+
+type commitFileFn func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+
+// commit commits the content of the file and records the SHA of the blob it
+// was committed as, which tells whether the file was changed since.
+func (e *repositoryFileExternal) commit(ctx context.Context, cr *v1alpha1.RepositoryFile, action string, fn commitFileFn) error {
+	p := cr.Spec.ForProvider
+	content, err := repositoryfiles.GetContent(ctx, e.client, p)
+	if err != nil {
+		return errors.Wrap(err, errGetRepositoryFileContent)
+	}
+
+	opts := repositoryfiles.GenerateFileOptions(p, action)
+	opts.Content = []byte(content)
+	if sha := cr.Status.AtProvider.SHA; sha != "" {
+		opts.SHA = ghclient.StringPtr(sha)
+	}
+	res, _, err := fn(ctx, p.Owner, p.Repository, p.Path, opts)
+	if err != nil {
+		return err
+	}
+
+	repositoryfiles.SetBlobSHA(cr, res.GetContent().GetSHA())
+	return errors.Wrap(e.client.Update(ctx, cr), errKubeUpdateRepositoryFile)
+}
@@ -0,0 +1,534 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/repositoryfiles"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeFilePath     = ".github/CODEOWNERS"
+	fakeFileContent  = "* @crossplane/platform\n"
+	fakeFileModified = "* @octocat\n"
+)
+
+type repositoryFileModifier func(*v1alpha1.RepositoryFile)
+
+func withFileContentConfigMapRef() repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) {
+		r.Spec.ForProvider.Content = nil
+		r.Spec.ForProvider.ContentConfigMapRef = &v1alpha1.ConfigMapKeySelector{
+			Name:      "codeowners",
+			Namespace: "crossplane-system",
+			Key:       "CODEOWNERS",
+		}
+	}
+}
+
+func withOverwriteChanges(overwrite bool) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Spec.ForProvider.OverwriteChanges = &overwrite }
+}
+
+func withCommittedContent(content string) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { repositoryfiles.SetBlobSHA(r, repositoryfiles.BlobSHA(content)) }
+}
+
+func withObservedContent(content string, modified bool) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) {
+		r.Status.AtProvider = v1alpha1.RepositoryFileObservation{SHA: repositoryfiles.BlobSHA(content), Modified: modified}
+	}
+}
+
+func newRepositoryFile(m ...repositoryFileModifier) *v1alpha1.RepositoryFile {
+	r := &v1alpha1.RepositoryFile{}
+	r.Spec.ForProvider = v1alpha1.RepositoryFileParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		Path:       fakeFilePath,
+		Content:    &fakeFileContent,
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func fileContents(content string) func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+	return func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+		return &github.RepositoryContent{
+			Path: github.String(path),
+			SHA:  github.String(repositoryfiles.BlobSHA(content)),
+			Size: github.Int(len(content)),
+		}, nil, nil, nil
+	}
+}
+
+// commitFile returns a fake that commits the supplied content if it is sent
+// with the supplied SHA of the file it replaces.
+func commitFile(sha string) func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+		if opts.GetSHA() != sha {
+			return nil, nil, errBoom
+		}
+		return &github.RepositoryContentResponse{
+			Content: &github.RepositoryContent{SHA: github.String(repositoryfiles.BlobSHA(string(opts.Content)))},
+		}, nil, nil
+	}
+}
+
+func fileConfigMap(content string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.ConfigMap).Data = map[string]string{"CODEOWNERS": content}
+		return nil
+	}
+}
+
+type repositoryFileArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github repositoryfiles.Service
+}
+
+func TestRepositoryFileObserve(t *testing.T) {
+	type want struct {
+		eo       managed.ExternalObservation
+		modified bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   repositoryFileArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryFile": {
+			reason: "Must return an error if the resource is not a RepositoryFile",
+			args: repositoryFileArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedRepositoryFile),
+			},
+		},
+		"CannotGetRepositoryFile": {
+			reason: "Must return an error if GET contents fails and the error is not 404",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+						return nil, nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetRepositoryFile),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the file does not exist",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+						return nil, nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"Directory": {
+			reason: "Must return an error if the path is a directory",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+						return nil, []*github.RepositoryContent{{}}, nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.New(errRepositoryFileIsDirectory),
+			},
+		},
+		"CannotGetConfigMap": {
+			reason: "Must return an error if the referenced ConfigMap cannot be read",
+			args: repositoryFileArgs{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg:   newRepositoryFile(withFileContentConfigMapRef()),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: fileContents(fakeFileContent),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get ConfigMap"), errGetRepositoryFileContent),
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the file has the content of the ConfigMap",
+			args: repositoryFileArgs{
+				kube: &test.MockClient{MockGet: fileConfigMap(fakeFileContent)},
+				mg:   newRepositoryFile(withFileContentConfigMapRef(), withCommittedContent(fakeFileContent)),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: fileContents(fakeFileContent),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AdoptFile": {
+			reason: "Must record the SHA of a file that already has the content and was not committed by the RepositoryFile",
+			args: repositoryFileArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg:   newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: fileContents(fakeFileContent),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CannotRecordBlobSHA": {
+			reason: "Must return an error if the SHA of an adopted file cannot be recorded",
+			args: repositoryFileArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: fileContents(fakeFileContent),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateRepositoryFile),
+			},
+		},
+		"ContentChanged": {
+			reason: "Must return ResourceUpToDate as false if the content given changed",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(withOverwriteChanges(false), withCommittedContent(fakeFileModified)),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: fileContents(fakeFileModified),
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"OverwriteChanges": {
+			reason: "Must return ResourceUpToDate as false if the file was modified and changes are overwritten",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(withCommittedContent(fakeFileContent)),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: fileContents(fakeFileModified),
+				},
+			},
+			want: want{
+				eo:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				modified: true,
+			},
+		},
+		"KeepChanges": {
+			reason: "Must return ResourceUpToDate as true if the file was modified and changes are not overwritten",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(withOverwriteChanges(false), withCommittedContent(fakeFileContent)),
+				github: &fake.MockRepositoryFileService{
+					MockGetContents: fileContents(fakeFileModified),
+				},
+			},
+			want: want{
+				eo:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				modified: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := repositoryFileExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.RepositoryFile); ok {
+				if diff := cmp.Diff(tc.want.modified, cr.Status.AtProvider.Modified); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want modified, +got modified:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRepositoryFileCreate(t *testing.T) {
+	type want struct {
+		sha string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   repositoryFileArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryFile": {
+			reason: "Must return an error if the resource is not a RepositoryFile",
+			args: repositoryFileArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedRepositoryFile),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the file cannot be committed",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockCreateFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateRepositoryFile),
+			},
+		},
+		"CannotUpdateManaged": {
+			reason: "Must return an error if the SHA of the committed file cannot be recorded",
+			args: repositoryFileArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockCreateFile: commitFile(""),
+				},
+			},
+			want: want{
+				sha: repositoryfiles.BlobSHA(fakeFileContent),
+				err: errors.Wrap(errors.Wrap(errBoom, errKubeUpdateRepositoryFile), errCreateRepositoryFile),
+			},
+		},
+		"Success": {
+			reason: "Must commit the content of the ConfigMap and record its SHA",
+			args: repositoryFileArgs{
+				kube: &test.MockClient{
+					MockGet:    fileConfigMap(fakeFileModified),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newRepositoryFile(withFileContentConfigMapRef()),
+				github: &fake.MockRepositoryFileService{
+					MockCreateFile: commitFile(""),
+				},
+			},
+			want: want{
+				sha: repositoryfiles.BlobSHA(fakeFileModified),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := repositoryFileExternal{gh: tc.args.github, client: tc.args.kube}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.sha != "" {
+				if diff := cmp.Diff(tc.want.sha, tc.args.mg.GetAnnotations()[repositoryfiles.AnnotationKeyBlobSHA]); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want blob SHA, +got blob SHA:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRepositoryFileUpdate(t *testing.T) {
+	observed := func(m ...repositoryFileModifier) *v1alpha1.RepositoryFile {
+		r := newRepositoryFile(m...)
+		r.Status.AtProvider.SHA = repositoryfiles.BlobSHA(fakeFileModified)
+		return r
+	}
+
+	type want struct {
+		sha string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   repositoryFileArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryFile": {
+			reason: "Must return an error if the resource is not a RepositoryFile",
+			args: repositoryFileArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedRepositoryFile),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the file cannot be committed",
+			args: repositoryFileArgs{
+				mg: observed(),
+				github: &fake.MockRepositoryFileService{
+					MockUpdateFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateRepositoryFile),
+			},
+		},
+		"Success": {
+			reason: "Must replace the observed file and record the SHA of the new one",
+			args: repositoryFileArgs{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg:   observed(withCommittedContent(fakeFileModified)),
+				github: &fake.MockRepositoryFileService{
+					MockUpdateFile: commitFile(repositoryfiles.BlobSHA(fakeFileModified)),
+				},
+			},
+			want: want{
+				sha: repositoryfiles.BlobSHA(fakeFileContent),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := repositoryFileExternal{gh: tc.args.github, client: tc.args.kube}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.sha != "" {
+				if diff := cmp.Diff(tc.want.sha, tc.args.mg.GetAnnotations()[repositoryfiles.AnnotationKeyBlobSHA]); diff != "" {
+					t.Errorf("\n%s\nUpdate(...): -want blob SHA, +got blob SHA:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRepositoryFileDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   repositoryFileArgs
+		want   error
+	}{
+		"ResourceIsNotRepositoryFile": {
+			reason: "Must return an error if the resource is not a RepositoryFile",
+			args: repositoryFileArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedRepositoryFile),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the file cannot be deleted",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockDeleteFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteRepositoryFile),
+		},
+		"NotFound": {
+			reason: "Must not return an error if the file is already gone",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(),
+				github: &fake.MockRepositoryFileService{
+					MockDeleteFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+		},
+		"Deleted": {
+			reason: "Must delete the file it last observed, including an adopted one",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(withObservedContent(fakeFileContent, false)),
+				github: &fake.MockRepositoryFileService{
+					MockDeleteFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						if opts.GetSHA() != repositoryfiles.BlobSHA(fakeFileContent) {
+							return nil, nil, errBoom
+						}
+						return &github.RepositoryContentResponse{}, nil, nil
+					},
+				},
+			},
+		},
+		"ModifiedOverwritten": {
+			reason: "Must delete a file that was changed since it was last committed if changes are overwritten",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(withObservedContent(fakeFileModified, true)),
+				github: &fake.MockRepositoryFileService{
+					MockDeleteFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						if opts.GetSHA() != repositoryfiles.BlobSHA(fakeFileModified) {
+							return nil, nil, errBoom
+						}
+						return &github.RepositoryContentResponse{}, nil, nil
+					},
+				},
+			},
+		},
+		"ModifiedKept": {
+			reason: "Must not delete a file that was changed since it was last committed if changes are not overwritten",
+			args: repositoryFileArgs{
+				mg: newRepositoryFile(withOverwriteChanges(false), withObservedContent(fakeFileModified, true)),
+				github: &fake.MockRepositoryFileService{
+					MockDeleteFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := repositoryFileExternal{gh: tc.args.github, client: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
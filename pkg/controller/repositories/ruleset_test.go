@@ -0,0 +1,362 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/rulesets"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeRulesetID   = int64(42)
+	fakeRulesetName = "default-branch"
+)
+
+func newRuleset(externalName, enforcement string) *v1alpha1.RepositoryRuleset {
+	r := &v1alpha1.RepositoryRuleset{}
+	meta.SetExternalName(r, externalName)
+	r.Spec.ForProvider = v1alpha1.RepositoryRulesetParameters{
+		Owner:       fakeOwner,
+		Repository:  fakeRepository,
+		Name:        fakeRulesetName,
+		Target:      github.String("branch"),
+		Enforcement: enforcement,
+		Rules:       []v1alpha1.RulesetRule{{Type: "deletion"}},
+	}
+	return r
+}
+
+func observedRuleset() *rulesets.Ruleset {
+	return &rulesets.Ruleset{
+		ID:          &fakeRulesetID,
+		Name:        fakeRulesetName,
+		Target:      github.String("branch"),
+		Enforcement: "active",
+		Rules:       []*rulesets.Rule{{Type: "deletion"}},
+	}
+}
+
+type rulesetArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github rulesets.Service
+}
+
+func TestRulesetObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   rulesetArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryRuleset": {
+			reason: "Must return an error if the resource is not a RepositoryRuleset",
+			args: rulesetArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedRuleset),
+			},
+		},
+		"NoExternalName": {
+			reason: "Must return ResourceExists as false if the ruleset was not created yet",
+			args: rulesetArgs{
+				mg: newRuleset("", "active"),
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"InvalidExternalName": {
+			reason: "Must return an error if the external name is not an ID",
+			args: rulesetArgs{
+				mg: newRuleset("default-branch", "active"),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(`strconv.ParseInt: parsing "default-branch": invalid syntax`), errRulesetID),
+			},
+		},
+		"CannotGetRuleset": {
+			reason: "Must return an error if GET ruleset fails and the error is not 404",
+			args: rulesetArgs{
+				mg: newRuleset("42", "active"),
+				github: &fake.MockRulesetService{
+					MockGetRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64) (*rulesets.Ruleset, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetRuleset),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the ruleset does not exist",
+			args: rulesetArgs{
+				mg: newRuleset("42", "active"),
+				github: &fake.MockRulesetService{
+					MockGetRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64) (*rulesets.Ruleset, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if the ruleset did not change",
+			args: rulesetArgs{
+				mg: newRuleset("42", "active"),
+				github: &fake.MockRulesetService{
+					MockGetRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64) (*rulesets.Ruleset, *github.Response, error) {
+						if id != fakeRulesetID {
+							return nil, nil, errNotFound
+						}
+						return observedRuleset(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			reason: "Must return ResourceUpToDate as false if the ruleset changed",
+			args: rulesetArgs{
+				mg: newRuleset("42", "evaluate"),
+				github: &fake.MockRulesetService{
+					MockGetRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64) (*rulesets.Ruleset, *github.Response, error) {
+						return observedRuleset(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := rulesetExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRulesetCreate(t *testing.T) {
+	type want struct {
+		eo           managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   rulesetArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryRuleset": {
+			reason: "Must return an error if the resource is not a RepositoryRuleset",
+			args: rulesetArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedRuleset),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the ruleset cannot be created",
+			args: rulesetArgs{
+				mg: newRuleset("", "active"),
+				github: &fake.MockRulesetService{
+					MockCreateRepositoryRuleset: func(ctx context.Context, owner, repo string, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateRuleset),
+			},
+		},
+		"Success": {
+			reason: "Must set the ID of the created ruleset as external name",
+			args: rulesetArgs{
+				mg: newRuleset("", "active"),
+				github: &fake.MockRulesetService{
+					MockCreateRepositoryRuleset: func(ctx context.Context, owner, repo string, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+						return observedRuleset(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo:           managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "42",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := rulesetExternal{gh: tc.args.github}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.externalName != "" {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRulesetUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   rulesetArgs
+		want   error
+	}{
+		"ResourceIsNotRepositoryRuleset": {
+			reason: "Must return an error if the resource is not a RepositoryRuleset",
+			args: rulesetArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedRuleset),
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the ruleset cannot be updated",
+			args: rulesetArgs{
+				mg: newRuleset("42", "evaluate"),
+				github: &fake.MockRulesetService{
+					MockUpdateRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errUpdateRuleset),
+		},
+		"Success": {
+			reason: "Must update the ruleset with the desired enforcement",
+			args: rulesetArgs{
+				mg: newRuleset("42", "evaluate"),
+				github: &fake.MockRulesetService{
+					MockUpdateRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64, rs *rulesets.Ruleset) (*rulesets.Ruleset, *github.Response, error) {
+						if id != fakeRulesetID || rs.Enforcement != "evaluate" {
+							return nil, nil, errBoom
+						}
+						return rs, nil, nil
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := rulesetExternal{gh: tc.args.github}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRulesetDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   rulesetArgs
+		want   error
+	}{
+		"ResourceIsNotRepositoryRuleset": {
+			reason: "Must return an error if the resource is not a RepositoryRuleset",
+			args: rulesetArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedRuleset),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the ruleset cannot be deleted",
+			args: rulesetArgs{
+				mg: newRuleset("42", "active"),
+				github: &fake.MockRulesetService{
+					MockDeleteRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteRuleset),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the ruleset no longer exists",
+			args: rulesetArgs{
+				mg: newRuleset("42", "active"),
+				github: &fake.MockRulesetService{
+					MockDeleteRepositoryRuleset: func(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := rulesetExternal{gh: tc.args.github}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+)
+
+// The errors of the secrets of all the secret stores, formatted with the
+// kind of the managed resource.
+const (
+	errUnexpectedSecret = "The managed resource is not of kind %s"
+	errGetSecretValue   = "cannot get value of %s"
+	errGetPublicKey     = "cannot get public key of Repository to encrypt %s"
+	errEncryptSecret    = "cannot encrypt %s"
+	errGetSecret        = "cannot get %s"
+	errCreateSecret     = "cannot create %s"
+	errUpdateSecret     = "cannot update %s"
+	errDeleteSecret     = "cannot delete %s"
+	errKubeUpdateSecret = "cannot update %s custom resource"
+)
+
+// repoSecretParameters are the parameters of the secrets of all the secret
+// stores of a Repository, which only differ by the name of their type.
+type repoSecretParameters v1alpha1.ActionsSecretParameters
+
+// A repoSecretKind is the managed resource of the secrets of one secret store
+// of a Repository.
+type repoSecretKind struct {
+	store     string
+	kind      string
+	groupKind string
+	gvk       schema.GroupVersionKind
+	object    func() resource.Managed
+
+	// parameters returns the parameters of the managed resource, or false
+	// if it is not of this kind.
+	parameters func(mg resource.Managed) (*repoSecretParameters, bool)
+
+	// observe sets the observation of the managed resource.
+	observe func(mg resource.Managed, s *github.Secret)
+}
+
+var (
+	actionsSecretKind = repoSecretKind{
+		store:     secretstores.StoreActions,
+		kind:      v1alpha1.ActionsSecretKind,
+		groupKind: v1alpha1.ActionsSecretGroupKind,
+		gvk:       v1alpha1.ActionsSecretGroupVersionKind,
+		object:    func() resource.Managed { return &v1alpha1.ActionsSecret{} },
+		parameters: func(mg resource.Managed) (*repoSecretParameters, bool) {
+			cr, ok := mg.(*v1alpha1.ActionsSecret)
+			if !ok {
+				return nil, false
+			}
+			return (*repoSecretParameters)(&cr.Spec.ForProvider), true
+		},
+		observe: func(mg resource.Managed, s *github.Secret) {
+			mg.(*v1alpha1.ActionsSecret).Status.AtProvider = secretstores.GenerateObservation(s)
+		},
+	}
+
+	dependabotSecretKind = repoSecretKind{
+		store:     secretstores.StoreDependabot,
+		kind:      v1alpha1.DependabotSecretKind,
+		groupKind: v1alpha1.DependabotSecretGroupKind,
+		gvk:       v1alpha1.DependabotSecretGroupVersionKind,
+		object:    func() resource.Managed { return &v1alpha1.DependabotSecret{} },
+		parameters: func(mg resource.Managed) (*repoSecretParameters, bool) {
+			cr, ok := mg.(*v1alpha1.DependabotSecret)
+			if !ok {
+				return nil, false
+			}
+			return (*repoSecretParameters)(&cr.Spec.ForProvider), true
+		},
+		observe: func(mg resource.Managed, s *github.Secret) {
+			mg.(*v1alpha1.DependabotSecret).Status.AtProvider = v1alpha1.DependabotSecretObservation(secretstores.GenerateObservation(s))
+		},
+	}
+
+	codespacesSecretKind = repoSecretKind{
+		store:     secretstores.StoreCodespaces,
+		kind:      v1alpha1.CodespacesSecretKind,
+		groupKind: v1alpha1.CodespacesSecretGroupKind,
+		gvk:       v1alpha1.CodespacesSecretGroupVersionKind,
+		object:    func() resource.Managed { return &v1alpha1.CodespacesSecret{} },
+		parameters: func(mg resource.Managed) (*repoSecretParameters, bool) {
+			cr, ok := mg.(*v1alpha1.CodespacesSecret)
+			if !ok {
+				return nil, false
+			}
+			return (*repoSecretParameters)(&cr.Spec.ForProvider), true
+		},
+		observe: func(mg resource.Managed, s *github.Secret) {
+			mg.(*v1alpha1.CodespacesSecret).Status.AtProvider = v1alpha1.CodespacesSecretObservation(secretstores.GenerateObservation(s))
+		},
+	}
+)
+
+// SetupActionsSecret adds a controller that reconciles ActionsSecrets.
+func SetupActionsSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	return setupRepoSecret(mgr, l, rl, actionsSecretKind)
+}
+
+// SetupDependabotSecret adds a controller that reconciles DependabotSecrets.
+func SetupDependabotSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	return setupRepoSecret(mgr, l, rl, dependabotSecretKind)
+}
+
+// SetupCodespacesSecret adds a controller that reconciles CodespacesSecrets.
+func SetupCodespacesSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	return setupRepoSecret(mgr, l, rl, codespacesSecretKind)
+}
+
+func setupRepoSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, k repoSecretKind) error {
+	name := managed.ControllerName(k.groupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(k.object()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(k.gvk),
+			managed.WithExternalConnecter(&repoSecretConnector{kind: k, client: mgr.GetClient(), newClientFn: secretstores.NewService}),
+			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type repoSecretConnector struct {
+	kind        repoSecretKind
+	client      client.Client
+	newClientFn func(cfg ghclient.Config, store string) (*secretstores.Service, error)
+}
+
+func (c *repoSecretConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := c.kind.parameters(mg); !ok {
+		return nil, errors.Errorf(errUnexpectedSecret, c.kind.kind)
+	}
+	cfg, err := ghclient.GetConfig(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	gh, err := c.newClientFn(*cfg, c.kind.store)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &repoSecretExternal{c.kind, *gh, c.client}, nil
+}
+
+type repoSecretExternal struct {
+	kind   repoSecretKind
+	gh     secretstores.Service
+	client client.Client
+}
+
+func (e *repoSecretExternal) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	s, _, err := e.gh.GetRepoSecret(ctx, p.Owner, p.Repository, p.Name)
+	if err != nil {
+		if ghclient.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetSecret, e.kind.kind)
+	}
+
+	v, err := ghclient.GetSecretValue(ctx, e.client, p.ValueSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetSecretValue, e.kind.kind)
+	}
+	key, _, err := e.gh.GetRepoPublicKey(ctx, p.Owner, p.Repository)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetPublicKey, e.kind.kind)
+	}
+
+	e.kind.observe(mgd, s)
+	mgd.SetConditions(xpv1.Available())
+
+	// GitHub never returns the value of a secret. It is sent again if it
+	// changed, or if it was encrypted with a public key of the store that
+	// was rotated since.
+	return managed.ExternalObservation{
+		ResourceUpToDate: ghclient.IsEncryptedSecretUpToDate(mgd, v.Version, key.GetKeyID()),
+		ResourceExists:   true,
+	}, nil
+}
+
+func (e *repoSecretExternal) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	if err := e.put(ctx, mgd, p, errCreateSecret); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	mgd.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *repoSecretExternal) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	return managed.ExternalUpdate{}, e.put(ctx, mgd, p, errUpdateSecret)
+}
+
+func (e *repoSecretExternal) Delete(ctx context.Context, mgd resource.Managed) error {
+	p, ok := e.kind.parameters(mgd)
+	if !ok {
+		return errors.Errorf(errUnexpectedSecret, e.kind.kind)
+	}
+
+	_, err := e.gh.DeleteRepoSecret(ctx, p.Owner, p.Repository, p.Name)
+	return errors.Wrapf(resource.Ignore(ghclient.IsNotFound, err), errDeleteSecret, e.kind.kind)
+}
+
+// put encrypts the value of the secret with the current public key of the
+// store of the Repository and sends it to GitHub. The version of the value
+// and the ID of the key are recorded in the annotations of the managed
+// resource. An error sending the secret is wrapped with errPut.
+func (e *repoSecretExternal) put(ctx context.Context, mgd resource.Managed, p *repoSecretParameters, errPut string) error {
+	v, err := ghclient.GetSecretValue(ctx, e.client, p.ValueSecretRef)
+	if err != nil {
+		return errors.Wrapf(err, errGetSecretValue, e.kind.kind)
+	}
+	key, _, err := e.gh.GetRepoPublicKey(ctx, p.Owner, p.Repository)
+	if err != nil {
+		return errors.Wrapf(err, errGetPublicKey, e.kind.kind)
+	}
+	s, err := ghclient.EncryptSecret(p.Name, key, v.Value)
+	if err != nil {
+		return errors.Wrapf(err, errEncryptSecret, e.kind.kind)
+	}
+	if _, err := e.gh.CreateOrUpdateRepoSecret(ctx, p.Owner, p.Repository, s); err != nil {
+		return errors.Wrapf(err, errPut, e.kind.kind)
+	}
+
+	ghclient.SetEncryptedSecret(mgd, v.Version, key.GetKeyID())
+	return errors.Wrapf(e.client.Update(ctx, mgd), errKubeUpdateSecret, e.kind.kind)
+}
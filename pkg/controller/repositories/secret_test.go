@@ -0,0 +1,497 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/box"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/secretstores"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeSecretName  = "DEPLOY_TOKEN"
+	fakeSecretValue = "t0k3n"
+	fakePublicKeyID = "568250167242549743"
+
+	fakeBoxPublicKey, fakeBoxPrivateKey, _ = box.GenerateKey(rand.Reader)
+
+	repoSecretKinds = []repoSecretKind{actionsSecretKind, dependabotSecretKind, codespacesSecretKind}
+)
+
+func newRepoSecret(k repoSecretKind, encryptedWith ...string) resource.Managed {
+	r := k.object()
+	p, _ := k.parameters(r)
+	*p = repoSecretParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		Name:       fakeSecretName,
+		ValueSecretRef: xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "deploy-token", Namespace: "crossplane-system"},
+			Key:             "secret",
+		},
+	}
+	if len(encryptedWith) == 2 {
+		ghclient.SetEncryptedSecret(r, secretVersion(encryptedWith[0]), encryptedWith[1])
+	}
+	return r
+}
+
+func repoPublicKey(keyID string) func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+	return func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+		return &github.PublicKey{
+			KeyID: github.String(keyID),
+			Key:   github.String(base64.StdEncoding.EncodeToString(fakeBoxPublicKey[:])),
+		}, nil, nil
+	}
+}
+
+func observedRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+	return &github.Secret{Name: name}, nil, nil
+}
+
+type repoSecretArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github secretstores.Service
+}
+
+func TestRepoSecretObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	for _, k := range repoSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   repoSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: repoSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"CannotGetSecret": {
+				reason: "Must return an error if GET secret fails and the error is not 404",
+				args: repoSecretArgs{
+					mg: newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoSecret: func(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+							return nil, nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errGetSecret, k.kind),
+				},
+			},
+			"NotFound": {
+				reason: "Must not return an error if the secret does not exist",
+				args: repoSecretArgs{
+					mg: newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoSecret: func(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+							return nil, nil, errNotFound
+						},
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{},
+				},
+			},
+			"CannotGetValue": {
+				reason: "Must return an error if the referenced Secret cannot be read",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+					mg:   newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoSecret: observedRepoSecret,
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errors.Wrap(errBoom, "cannot get Secret"), errGetSecretValue, k.kind),
+				},
+			},
+			"CannotGetPublicKey": {
+				reason: "Must return an error if the public key of the Repository cannot be read",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoSecret: observedRepoSecret,
+						MockGetRepoPublicKey: func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+							return nil, nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errGetPublicKey, k.kind),
+				},
+			},
+			"UpToDate": {
+				reason: "Must return ResourceUpToDate as true if neither the value nor the public key changed",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newRepoSecret(k, fakeSecretValue, fakePublicKeyID),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoSecret:    observedRepoSecret,
+						MockGetRepoPublicKey: repoPublicKey(fakePublicKeyID),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+					},
+				},
+			},
+			"ValueChanged": {
+				reason: "Must return ResourceUpToDate as false if the value of the secret changed",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret("rotated")},
+					mg:   newRepoSecret(k, fakeSecretValue, fakePublicKeyID),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoSecret:    observedRepoSecret,
+						MockGetRepoPublicKey: repoPublicKey(fakePublicKeyID),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: false,
+					},
+				},
+			},
+			"PublicKeyRotated": {
+				reason: "Must return ResourceUpToDate as false if the public key of the Repository was rotated",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newRepoSecret(k, fakeSecretValue, fakePublicKeyID),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoSecret:    observedRepoSecret,
+						MockGetRepoPublicKey: repoPublicKey("1234"),
+					},
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: false,
+					},
+				},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := repoSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					got, err := e.Observe(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+						t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+					}
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestRepoSecretCreate(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalCreation
+		err error
+	}
+
+	for _, k := range repoSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   repoSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: repoSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"CannotGetPublicKey": {
+				reason: "Must return an error if the public key of the Repository cannot be read",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoPublicKey: func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+							return nil, nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errGetPublicKey, k.kind),
+				},
+			},
+			"CreationFailed": {
+				reason: "Must return an error if the secret cannot be created",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoPublicKey: repoPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateRepoSecret: func(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errCreateSecret, k.kind),
+				},
+			},
+			"Success": {
+				reason: "Must send the value encrypted with the public key of the Repository",
+				args: repoSecretArgs{
+					kube: &test.MockClient{
+						MockGet:    webhookSecret(fakeSecretValue),
+						MockUpdate: test.NewMockUpdateFn(nil),
+					},
+					mg: newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoPublicKey: repoPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateRepoSecret: func(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							sealed, err := base64.StdEncoding.DecodeString(eSecret.EncryptedValue)
+							if err != nil {
+								return nil, err
+							}
+							v, ok := box.OpenAnonymous(nil, sealed, fakeBoxPublicKey, fakeBoxPrivateKey)
+							if !ok || string(v) != fakeSecretValue || eSecret.KeyID != fakePublicKeyID {
+								return nil, errBoom
+							}
+							return nil, nil
+						},
+					},
+				},
+				want: want{
+					eo: managed.ExternalCreation{},
+				},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := repoSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					got, err := e.Create(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+						t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+					}
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestRepoSecretUpdate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	for _, k := range repoSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   repoSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: repoSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"UpdateFailed": {
+				reason: "Must return an error if the secret cannot be sent",
+				args: repoSecretArgs{
+					kube: &test.MockClient{MockGet: webhookSecret(fakeSecretValue)},
+					mg:   newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoPublicKey: repoPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateRepoSecret: func(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errUpdateSecret, k.kind),
+				},
+			},
+			"KubeUpdateFailed": {
+				reason: "Must return an error if the version of the value cannot be recorded",
+				args: repoSecretArgs{
+					kube: &test.MockClient{
+						MockGet:    webhookSecret(fakeSecretValue),
+						MockUpdate: test.NewMockUpdateFn(errBoom),
+					},
+					mg: newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoPublicKey: repoPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateRepoSecret: func(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							return nil, nil
+						},
+					},
+				},
+				want: want{
+					upToDate: true,
+					err:      errors.Wrapf(errBoom, errKubeUpdateSecret, k.kind),
+				},
+			},
+			"Success": {
+				reason: "Must encrypt the value with the rotated public key and record its ID",
+				args: repoSecretArgs{
+					kube: &test.MockClient{
+						MockGet:    webhookSecret(fakeSecretValue),
+						MockUpdate: test.NewMockUpdateFn(nil),
+					},
+					mg: newRepoSecret(k, fakeSecretValue, "1234"),
+					github: &fake.MockSecretStoreService{
+						MockGetRepoPublicKey: repoPublicKey(fakePublicKeyID),
+						MockCreateOrUpdateRepoSecret: func(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+							if eSecret.Name != fakeSecretName || eSecret.KeyID != fakePublicKeyID {
+								return nil, errBoom
+							}
+							return nil, nil
+						},
+					},
+				},
+				want: want{
+					upToDate: true,
+				},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := repoSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					_, err := e.Update(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+					if _, ok := k.parameters(tc.args.mg); ok {
+						got := ghclient.IsEncryptedSecretUpToDate(tc.args.mg, secretVersion(fakeSecretValue), fakePublicKeyID)
+						if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+							t.Errorf("\n%s\nUpdate(...): -want up to date, +got up to date:\n%s", tc.reason, diff)
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestRepoSecretDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	for _, k := range repoSecretKinds {
+		cases := map[string]struct {
+			reason string
+			args   repoSecretArgs
+			want   want
+		}{
+			"ResourceIsNotOfKind": {
+				reason: "Must return an error if the resource is not of the kind",
+				args: repoSecretArgs{
+					mg: unexpectedObject,
+				},
+				want: want{
+					err: errors.Errorf(errUnexpectedSecret, k.kind),
+				},
+			},
+			"DeleteFailed": {
+				reason: "Must return an error if DELETE secret fails and the error is not 404",
+				args: repoSecretArgs{
+					mg: newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockDeleteRepoSecret: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errDeleteSecret, k.kind),
+				},
+			},
+			"NotFound": {
+				reason: "Must not return an error if the secret was already deleted",
+				args: repoSecretArgs{
+					mg: newRepoSecret(k),
+					github: &fake.MockSecretStoreService{
+						MockDeleteRepoSecret: func(ctx context.Context, owner, repo, name string) (*github.Response, error) {
+							return nil, errNotFound
+						},
+					},
+				},
+				want: want{
+					err: nil,
+				},
+			},
+		}
+
+		t.Run(k.kind, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					e := repoSecretExternal{kind: k, gh: tc.args.github, client: tc.args.kube}
+					err := e.Delete(context.Background(), tc.args.mg)
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+					}
+				})
+			}
+		})
+	}
+}
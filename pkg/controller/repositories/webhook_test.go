@@ -0,0 +1,528 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v33/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-github/apis/repositories/v1alpha1"
+	ghclient "github.com/crossplane-contrib/provider-github/pkg/clients"
+	"github.com/crossplane-contrib/provider-github/pkg/clients/webhooks"
+	"github.com/crossplane-contrib/provider-github/pkg/controller/repositories/fake"
+)
+
+var (
+	fakeWebhookID     = int64(42)
+	fakeWebhookURL    = "https://ci.example.org/hooks/github"
+	fakeWebhookSecret = "s3cr3t"
+)
+
+type webhookModifier func(*v1alpha1.RepositoryWebhook)
+
+func withWebhookEvents(events ...string) webhookModifier {
+	return func(r *v1alpha1.RepositoryWebhook) { r.Spec.ForProvider.Events = events }
+}
+
+func withSecretVersion(secret string) webhookModifier {
+	return func(r *v1alpha1.RepositoryWebhook) {
+		ghclient.SetSecretVersion(r, secretVersion(secret))
+	}
+}
+
+func newWebhook(externalName string, m ...webhookModifier) *v1alpha1.RepositoryWebhook {
+	r := &v1alpha1.RepositoryWebhook{}
+	meta.SetExternalName(r, externalName)
+	r.Spec.ForProvider = v1alpha1.RepositoryWebhookParameters{
+		Owner:      fakeOwner,
+		Repository: fakeRepository,
+		WebhookParameters: v1alpha1.WebhookParameters{
+			URL:         fakeWebhookURL,
+			ContentType: github.String("json"),
+			SecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "webhook", Namespace: "crossplane-system"},
+				Key:             "secret",
+			},
+			InsecureSSL: &fakeFalse,
+			Events:      []string{"push"},
+			Active:      &fakeTrue,
+		},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+// GitHub masks the secret of a webhook in its responses.
+func observedWebhook() *webhooks.Hook {
+	return &webhooks.Hook{
+		Hook: github.Hook{
+			ID: &fakeWebhookID,
+			Config: map[string]interface{}{
+				"url":          fakeWebhookURL,
+				"content_type": "json",
+				"insecure_ssl": "0",
+				"secret":       "********",
+			},
+			Events: []string{"push"},
+			Active: &fakeTrue,
+		},
+		PingURL: github.String("https://api.github.com/repos/crossplane/sample/hooks/42/pings"),
+	}
+}
+
+// kubeSecret returns a Secret that holds the supplied secret. Its resource
+// version differs for every secret.
+func kubeSecret(secret string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{UID: "2f0b4f6a", ResourceVersion: secret},
+		Data:       map[string][]byte{"secret": []byte(secret)},
+	}
+}
+
+func webhookSecret(secret string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		kubeSecret(secret).DeepCopyInto(obj.(*corev1.Secret))
+		return nil
+	}
+}
+
+func secretVersion(secret string) string {
+	return ghclient.SecretVersion(kubeSecret(secret), "secret")
+}
+
+type webhookArgs struct {
+	kube   client.Client
+	mg     resource.Managed
+	github webhooks.Service
+}
+
+func TestWebhookObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   webhookArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryWebhook": {
+			reason: "Must return an error if the resource is not a RepositoryWebhook",
+			args: webhookArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedWebhook),
+			},
+		},
+		"NoExternalName": {
+			reason: "Must return ResourceExists as false if the webhook was not created yet",
+			args: webhookArgs{
+				mg: newWebhook(""),
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"CannotGetWebhook": {
+			reason: "Must return an error if GET webhook fails and the error is not 404",
+			args: webhookArgs{
+				mg: newWebhook("42"),
+				github: &fake.MockWebhookService{
+					MockGetHook: func(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetWebhook),
+			},
+		},
+		"NotFound": {
+			reason: "Must not return an error if the webhook does not exist",
+			args: webhookArgs{
+				mg: newWebhook("42"),
+				github: &fake.MockWebhookService{
+					MockGetHook: func(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{},
+			},
+		},
+		"CannotGetSecret": {
+			reason: "Must return an error if the referenced Secret cannot be read",
+			args: webhookArgs{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg:   newWebhook("42"),
+				github: &fake.MockWebhookService{
+					MockGetHook: func(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error) {
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get Secret"), errGetWebhookSecret),
+			},
+		},
+		"UpToDate": {
+			reason: "Must return ResourceUpToDate as true if neither the webhook nor its secret changed",
+			args: webhookArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeWebhookSecret)},
+				mg:   newWebhook("42", withSecretVersion(fakeWebhookSecret)),
+				github: &fake.MockWebhookService{
+					MockGetHook: func(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error) {
+						if id != fakeWebhookID {
+							return nil, nil, errNotFound
+						}
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EventsChanged": {
+			reason: "Must return ResourceUpToDate as false if the events of the webhook changed",
+			args: webhookArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeWebhookSecret)},
+				mg:   newWebhook("42", withSecretVersion(fakeWebhookSecret), withWebhookEvents("push", "pull_request")),
+				github: &fake.MockWebhookService{
+					MockGetHook: func(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error) {
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"SecretChanged": {
+			reason: "Must return ResourceUpToDate as false if the value of the secret changed",
+			args: webhookArgs{
+				kube: &test.MockClient{MockGet: webhookSecret("rotated")},
+				mg:   newWebhook("42", withSecretVersion(fakeWebhookSecret)),
+				github: &fake.MockWebhookService{
+					MockGetHook: func(ctx context.Context, owner, repo string, id int64) (*webhooks.Hook, *github.Response, error) {
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := webhookExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWebhookCreate(t *testing.T) {
+	type want struct {
+		eo           managed.ExternalCreation
+		externalName string
+		version      string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   webhookArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryWebhook": {
+			reason: "Must return an error if the resource is not a RepositoryWebhook",
+			args: webhookArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedWebhook),
+			},
+		},
+		"CannotGetSecret": {
+			reason: "Must return an error if the referenced Secret cannot be read",
+			args: webhookArgs{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg:   newWebhook(""),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get Secret"), errGetWebhookSecret),
+			},
+		},
+		"CreationFailed": {
+			reason: "Must return an error if the webhook cannot be created",
+			args: webhookArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeWebhookSecret)},
+				mg:   newWebhook(""),
+				github: &fake.MockWebhookService{
+					MockCreateHook: func(ctx context.Context, owner, repo string, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateWebhook),
+			},
+		},
+		"KubeUpdateFailed": {
+			reason: "Must return an error if the external name and the version cannot be persisted",
+			args: webhookArgs{
+				kube: &test.MockClient{
+					MockGet:    webhookSecret(fakeWebhookSecret),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newWebhook(""),
+				github: &fake.MockWebhookService{
+					MockCreateHook: func(ctx context.Context, owner, repo string, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo:  managed.ExternalCreation{ExternalNameAssigned: true},
+				err: errors.Wrap(errBoom, errKubeUpdateWebhook),
+			},
+		},
+		"Success": {
+			reason: "Must create the webhook with the secret, and record its ID as external name and the version of the secret",
+			args: webhookArgs{
+				kube: &test.MockClient{
+					MockGet:    webhookSecret(fakeWebhookSecret),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newWebhook(""),
+				github: &fake.MockWebhookService{
+					MockCreateHook: func(ctx context.Context, owner, repo string, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+						if hook.Config["secret"] != fakeWebhookSecret {
+							return nil, nil, errBoom
+						}
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				eo:           managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "42",
+				version:      secretVersion(fakeWebhookSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := webhookExternal{gh: tc.args.github, client: tc.args.kube}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.externalName != "" {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+			if tc.want.version != "" {
+				if diff := cmp.Diff(tc.want.version, tc.args.mg.GetAnnotations()[ghclient.AnnotationKeySecretVersion]); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want version, +got version:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookUpdate(t *testing.T) {
+	type want struct {
+		version string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   webhookArgs
+		want   want
+	}{
+		"ResourceIsNotRepositoryWebhook": {
+			reason: "Must return an error if the resource is not a RepositoryWebhook",
+			args: webhookArgs{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errUnexpectedWebhook),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Must return an error if the webhook cannot be updated",
+			args: webhookArgs{
+				kube: &test.MockClient{MockGet: webhookSecret(fakeWebhookSecret)},
+				mg:   newWebhook("42"),
+				github: &fake.MockWebhookService{
+					MockEditHook: func(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateWebhook),
+			},
+		},
+		"KubeUpdateFailed": {
+			reason: "Must return an error if the version of the secret cannot be recorded",
+			args: webhookArgs{
+				kube: &test.MockClient{
+					MockGet:    webhookSecret(fakeWebhookSecret),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newWebhook("42"),
+				github: &fake.MockWebhookService{
+					MockEditHook: func(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				version: secretVersion(fakeWebhookSecret),
+				err:     errors.Wrap(errBoom, errKubeUpdateWebhook),
+			},
+		},
+		"Success": {
+			reason: "Must send the secret and record its version",
+			args: webhookArgs{
+				kube: &test.MockClient{
+					MockGet:    webhookSecret("rotated"),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				mg: newWebhook("42", withSecretVersion(fakeWebhookSecret)),
+				github: &fake.MockWebhookService{
+					MockEditHook: func(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*webhooks.Hook, *github.Response, error) {
+						if id != fakeWebhookID || hook.Config["secret"] != "rotated" {
+							return nil, nil, errBoom
+						}
+						return observedWebhook(), nil, nil
+					},
+				},
+			},
+			want: want{
+				version: secretVersion("rotated"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := webhookExternal{gh: tc.args.github, client: tc.args.kube}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.version != "" {
+				if diff := cmp.Diff(tc.want.version, tc.args.mg.GetAnnotations()[ghclient.AnnotationKeySecretVersion]); diff != "" {
+					t.Errorf("\n%s\nUpdate(...): -want version, +got version:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		args   webhookArgs
+		want   error
+	}{
+		"ResourceIsNotRepositoryWebhook": {
+			reason: "Must return an error if the resource is not a RepositoryWebhook",
+			args: webhookArgs{
+				mg: unexpectedObject,
+			},
+			want: errors.New(errUnexpectedWebhook),
+		},
+		"DeleteFailed": {
+			reason: "Must return an error if the webhook cannot be deleted",
+			args: webhookArgs{
+				mg: newWebhook("42"),
+				github: &fake.MockWebhookService{
+					MockDeleteHook: func(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: errors.Wrap(errBoom, errDeleteWebhook),
+		},
+		"AlreadyDeleted": {
+			reason: "Must not return an error if the webhook no longer exists",
+			args: webhookArgs{
+				mg: newWebhook("42"),
+				github: &fake.MockWebhookService{
+					MockDeleteHook: func(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+						return nil, errNotFound
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := webhookExternal{gh: tc.args.github}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}